@@ -12,62 +12,25 @@ import (
 )
 
 type Campaign struct {
-	Id                string                `json:"id"`
-	WebId             int                   `json:"web_id"`
-	Type              string                `json:"type"`
-	CreateTime        types.DateTimeString  `json:"create_time"`
-	ArchiveUrl        string                `json:"archive_url"`
-	LongArchiveUrl    string                `json:"long_archive_url"`
-	Status            string                `json:"status"`
-	EmailsSent        int                   `json:"emails_sent"`
-	SendTime          *types.DateTimeString `json:"send_time"`
-	ContentType       string                `json:"content_type"`
-	NeedsBlockRefresh bool                  `json:"needs_block_refresh"`
-	Resendable        bool                  `json:"resendable"`
-	Recipients        struct {
-		ListId         string `json:"list_id"`
-		ListIsActive   bool   `json:"list_is_active"`
-		ListName       string `json:"list_name"`
-		SegmentText    string `json:"segment_text"`
-		RecipientCount int    `json:"recipient_count"`
-		SegmentOpts    struct {
-			SavedSegmentId int    `json:"saved_segment_id"`
-			Match          string `json:"match"`
-			Conditions     []struct {
-				ConditionType string `json:"condition_type"`
-				Field         string `json:"field"`
-				Op            string `json:"op"`
-				Value         int    `json:"value"`
-			} `json:"conditions"`
-		} `json:"segment_opts"`
-	} `json:"recipients"`
-	Settings struct {
-		SubjectLine     string `json:"subject_line"`
-		Title           string `json:"title"`
-		FromName        string `json:"from_name"`
-		ReplyTo         string `json:"reply_to"`
-		UseConversation bool   `json:"use_conversation"`
-		ToName          string `json:"to_name"`
-		FolderId        string `json:"folder_id"`
-		Authenticate    bool   `json:"authenticate"`
-		AutoFooter      bool   `json:"auto_footer"`
-		InlineCss       bool   `json:"inline_css"`
-		AutoTweet       bool   `json:"auto_tweet"`
-		FbComments      bool   `json:"fb_comments"`
-		Timewarp        bool   `json:"timewarp"`
-		TemplateId      int    `json:"template_id"`
-		DragAndDrop     bool   `json:"drag_and_drop"`
-	} `json:"settings"`
-	Tracking struct {
-		Opens           bool   `json:"opens"`
-		HtmlClicks      bool   `json:"html_clicks"`
-		TextClicks      bool   `json:"text_clicks"`
-		GoalTracking    bool   `json:"goal_tracking"`
-		Ecomm360        bool   `json:"ecomm360"`
-		GoogleAnalytics string `json:"google_analytics"`
-		Clicktale       string `json:"clicktale"`
-	} `json:"tracking"`
-	ReportSummary struct {
+	Id                string                   `json:"id"`
+	WebId             int                      `json:"web_id"`
+	Type              string                   `json:"type"`
+	CreateTime        types.DateTimeString     `json:"create_time"`
+	ArchiveUrl        string                   `json:"archive_url"`
+	LongArchiveUrl    string                   `json:"long_archive_url"`
+	Status            string                   `json:"status"`
+	EmailsSent        int                      `json:"emails_sent"`
+	SendTime          *types.DateTimeString    `json:"send_time"`
+	ContentType       string                   `json:"content_type"`
+	NeedsBlockRefresh bool                     `json:"needs_block_refresh"`
+	Resendable        bool                     `json:"resendable"`
+	Recipients        CampaignRecipients       `json:"recipients"`
+	Settings          CampaignSettings         `json:"settings"`
+	Tracking          CampaignTracking         `json:"tracking"`
+	VariateSettings   *CampaignVariateSettings `json:"variate_settings"`
+	AbSplitOpts       *CampaignAbSplitOpts     `json:"ab_split_opts"`
+	RssOpts           *CampaignRssOpts         `json:"rss_opts"`
+	ReportSummary     struct {
 		Opens            int     `json:"opens"`
 		UniqueOpens      int     `json:"unique_opens"`
 		OpenRate         float64 `json:"open_rate"`
@@ -86,6 +49,211 @@ type Campaign struct {
 	Links []Link `json:"_links"`
 }
 
+type CampaignRecipients struct {
+	ListId         string                 `json:"list_id"`
+	ListIsActive   bool                   `json:"list_is_active"`
+	ListName       string                 `json:"list_name"`
+	SegmentText    string                 `json:"segment_text"`
+	RecipientCount int                    `json:"recipient_count"`
+	SegmentOpts    CampaignSegmentOptions `json:"segment_opts"`
+}
+
+type CampaignSegmentOptions struct {
+	SavedSegmentId    int                `json:"saved_segment_id,omitempty"`
+	PrebuiltSegmentId string             `json:"prebuilt_segment_id,omitempty"`
+	Match             SegmentMatch       `json:"match,omitempty"`
+	Conditions        []SegmentCondition `json:"conditions,omitempty"`
+}
+
+type CampaignSettings struct {
+	SubjectLine     string `json:"subject_line"`
+	PreviewText     string `json:"preview_text"`
+	Title           string `json:"title"`
+	FromName        string `json:"from_name"`
+	ReplyTo         string `json:"reply_to"`
+	UseConversation bool   `json:"use_conversation"`
+	ToName          string `json:"to_name"`
+	FolderId        string `json:"folder_id"`
+	Authenticate    bool   `json:"authenticate"`
+	AutoFooter      bool   `json:"auto_footer"`
+	InlineCss       bool   `json:"inline_css"`
+	AutoTweet       bool   `json:"auto_tweet"`
+	FbComments      bool   `json:"fb_comments"`
+	Timewarp        bool   `json:"timewarp"`
+	TemplateId      int    `json:"template_id"`
+	DragAndDrop     bool   `json:"drag_and_drop"`
+}
+
+type CampaignTracking struct {
+	Opens           bool   `json:"opens"`
+	HtmlClicks      bool   `json:"html_clicks"`
+	TextClicks      bool   `json:"text_clicks"`
+	GoalTracking    bool   `json:"goal_tracking"`
+	Ecomm360        bool   `json:"ecomm360"`
+	GoogleAnalytics string `json:"google_analytics"`
+	Clicktale       string `json:"clicktale"`
+}
+
+// CampaignRecipientsConfig holds the recipients sent when creating or updating a campaign
+type CampaignRecipientsConfig struct {
+	ListId      string                  `json:"list_id"`
+	SegmentOpts *CampaignSegmentOptions `json:"segment_opts,omitempty"`
+}
+
+// CampaignSettingsConfig holds the settings sent when creating or updating a campaign, settings left nil are not changed
+type CampaignSettingsConfig struct {
+	SubjectLine     *string `json:"subject_line,omitempty"`
+	PreviewText     *string `json:"preview_text,omitempty"`
+	Title           *string `json:"title,omitempty"`
+	FromName        *string `json:"from_name,omitempty"`
+	ReplyTo         *string `json:"reply_to,omitempty"`
+	UseConversation *bool   `json:"use_conversation,omitempty"`
+	ToName          *string `json:"to_name,omitempty"`
+	FolderId        *string `json:"folder_id,omitempty"`
+	Authenticate    *bool   `json:"authenticate,omitempty"`
+	AutoFooter      *bool   `json:"auto_footer,omitempty"`
+	InlineCss       *bool   `json:"inline_css,omitempty"`
+	AutoTweet       *bool   `json:"auto_tweet,omitempty"`
+	FbComments      *bool   `json:"fb_comments,omitempty"`
+	Timewarp        *bool   `json:"timewarp,omitempty"`
+	TemplateId      *int    `json:"template_id,omitempty"`
+	DragAndDrop     *bool   `json:"drag_and_drop,omitempty"`
+}
+
+// CampaignTrackingConfig holds the tracking sent when creating or updating a campaign, settings left nil are not changed
+type CampaignTrackingConfig struct {
+	Opens           *bool   `json:"opens,omitempty"`
+	HtmlClicks      *bool   `json:"html_clicks,omitempty"`
+	TextClicks      *bool   `json:"text_clicks,omitempty"`
+	GoalTracking    *bool   `json:"goal_tracking,omitempty"`
+	Ecomm360        *bool   `json:"ecomm360,omitempty"`
+	GoogleAnalytics *string `json:"google_analytics,omitempty"`
+	Clicktale       *string `json:"clicktale,omitempty"`
+}
+
+// CampaignVariateSettingsConfig holds the variate settings sent when creating or updating a multivariate campaign
+type CampaignVariateSettingsConfig struct {
+	WinnerCriteria   *VariateWinnerCriteria  `json:"winner_criteria,omitempty"`
+	WaitTime         *int                    `json:"wait_time,omitempty"`
+	TestSize         *int                    `json:"test_size,omitempty"`
+	SubjectLines     *[]string               `json:"subject_lines,omitempty"`
+	SendTimes        *[]types.DateTimeString `json:"send_times,omitempty"`
+	FromNames        *[]string               `json:"from_names,omitempty"`
+	ReplyToAddresses *[]string               `json:"reply_to_addresses,omitempty"`
+}
+
+// CampaignVariateSettings holds the settings of a multivariate campaign
+type CampaignVariateSettings struct {
+	WinningCombinationId string                       `json:"winning_combination_id,omitempty"`
+	WinningCampaignId    string                       `json:"winning_campaign_id,omitempty"`
+	WinnerCriteria       VariateWinnerCriteria        `json:"winner_criteria"`
+	WaitTime             int                          `json:"wait_time,omitempty"`
+	TestSize             int                          `json:"test_size,omitempty"`
+	SubjectLines         []string                     `json:"subject_lines,omitempty"`
+	SendTimes            []types.DateTimeString       `json:"send_times,omitempty"`
+	FromNames            []string                     `json:"from_names,omitempty"`
+	ReplyToAddresses     []string                     `json:"reply_to_addresses,omitempty"`
+	Contents             []string                     `json:"contents,omitempty"`
+	Combinations         []CampaignVariateCombination `json:"combinations,omitempty"`
+}
+
+// CampaignVariateCombination refers to the variations of a combination by their index in CampaignVariateSettings
+type CampaignVariateCombination struct {
+	Id                 string `json:"id"`
+	SubjectLine        int    `json:"subject_line"`
+	SendTime           int    `json:"send_time"`
+	FromName           int    `json:"from_name"`
+	ReplyTo            int    `json:"reply_to"`
+	ContentDescription int    `json:"content_description"`
+	Recipients         int    `json:"recipients"`
+}
+
+type VariateWinnerCriteria string
+
+const (
+	VariateWinnerCriteriaOpens        VariateWinnerCriteria = "opens"
+	VariateWinnerCriteriaClicks       VariateWinnerCriteria = "clicks"
+	VariateWinnerCriteriaManual       VariateWinnerCriteria = "manual"
+	VariateWinnerCriteriaTotalRevenue VariateWinnerCriteria = "total_revenue"
+)
+
+// CampaignAbSplitOpts holds the settings of a legacy A/B split campaign
+type CampaignAbSplitOpts struct {
+	SplitTest      string                `json:"split_test"`
+	PickWinner     string                `json:"pick_winner"`
+	WaitUnits      string                `json:"wait_units"`
+	WaitTime       int                   `json:"wait_time"`
+	SplitSize      int                   `json:"split_size"`
+	FromNameA      string                `json:"from_name_a"`
+	FromNameB      string                `json:"from_name_b"`
+	ReplyEmailA    string                `json:"reply_email_a"`
+	ReplyEmailB    string                `json:"reply_email_b"`
+	SubjectA       string                `json:"subject_a"`
+	SubjectB       string                `json:"subject_b"`
+	SendTimeA      *types.DateTimeString `json:"send_time_a"`
+	SendTimeB      *types.DateTimeString `json:"send_time_b"`
+	SendTimeWinner string                `json:"send_time_winner"`
+}
+
+// CampaignRssOpts holds the settings of an RSS campaign
+type CampaignRssOpts struct {
+	FeedUrl         string                `json:"feed_url"`
+	Frequency       RssFrequency          `json:"frequency"`
+	Schedule        *CampaignRssSchedule  `json:"schedule,omitempty"`
+	LastSent        *types.DateTimeString `json:"last_sent,omitempty"`
+	ConstrainRssImg bool                  `json:"constrain_rss_img"`
+}
+
+type CampaignRssSchedule struct {
+	Hour            int                   `json:"hour"`
+	DailySend       *CampaignRssDailySend `json:"daily_send,omitempty"`
+	WeeklySendDay   string                `json:"weekly_send_day,omitempty"`
+	MonthlySendDate float64               `json:"monthly_send_date,omitempty"`
+}
+
+type CampaignRssDailySend struct {
+	Sunday    bool `json:"sunday"`
+	Monday    bool `json:"monday"`
+	Tuesday   bool `json:"tuesday"`
+	Wednesday bool `json:"wednesday"`
+	Thursday  bool `json:"thursday"`
+	Friday    bool `json:"friday"`
+	Saturday  bool `json:"saturday"`
+}
+
+// CampaignRssOptsConfig holds the RSS settings sent when creating or updating an RSS campaign, settings left nil are not changed
+type CampaignRssOptsConfig struct {
+	FeedUrl         *string                    `json:"feed_url,omitempty"`
+	Frequency       *RssFrequency              `json:"frequency,omitempty"`
+	Schedule        *CampaignRssScheduleConfig `json:"schedule,omitempty"`
+	ConstrainRssImg *bool                      `json:"constrain_rss_img,omitempty"`
+}
+
+type CampaignRssScheduleConfig struct {
+	Hour            *int                        `json:"hour,omitempty"`
+	DailySend       *CampaignRssDailySendConfig `json:"daily_send,omitempty"`
+	WeeklySendDay   *string                     `json:"weekly_send_day,omitempty"`
+	MonthlySendDate *float64                    `json:"monthly_send_date,omitempty"`
+}
+
+type CampaignRssDailySendConfig struct {
+	Sunday    *bool `json:"sunday,omitempty"`
+	Monday    *bool `json:"monday,omitempty"`
+	Tuesday   *bool `json:"tuesday,omitempty"`
+	Wednesday *bool `json:"wednesday,omitempty"`
+	Thursday  *bool `json:"thursday,omitempty"`
+	Friday    *bool `json:"friday,omitempty"`
+	Saturday  *bool `json:"saturday,omitempty"`
+}
+
+type RssFrequency string
+
+const (
+	RssFrequencyDaily   RssFrequency = "daily"
+	RssFrequencyWeekly  RssFrequency = "weekly"
+	RssFrequencyMonthly RssFrequency = "monthly"
+)
+
 type CampaignType string
 
 const (
@@ -221,3 +389,159 @@ func (service *Service) ListCampaigns(cfg *ListCampaignsConfig) (*[]Campaign, *e
 
 	return &campaigns, nil
 }
+
+type GetCampaignConfig struct {
+	CampaignId    string
+	Fields        *[]string
+	ExcludeFields *[]string
+}
+
+func (service *Service) GetCampaign(cfg *GetCampaignConfig) (*Campaign, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("GetCampaignConfig must not be nil")
+	}
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var campaign Campaign
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("campaigns/%s?%s", cfg.CampaignId, values.Encode())),
+		ResponseModel: &campaign,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &campaign, nil
+}
+
+type CreateCampaignConfig struct {
+	Type            CampaignType                   `json:"type"`
+	Recipients      *CampaignRecipientsConfig      `json:"recipients,omitempty"`
+	Settings        *CampaignSettingsConfig        `json:"settings,omitempty"`
+	Tracking        *CampaignTrackingConfig        `json:"tracking,omitempty"`
+	VariateSettings *CampaignVariateSettingsConfig `json:"variate_settings,omitempty"`
+	RssOpts         *CampaignRssOptsConfig         `json:"rss_opts,omitempty"`
+	ContentType     *string                        `json:"content_type,omitempty"`
+}
+
+func (service *Service) CreateCampaign(cfg *CreateCampaignConfig) (*Campaign, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("CreateCampaignConfig must not be nil")
+	}
+
+	if cfg.Type == "" {
+		return nil, errortools.ErrorMessage("Type not provided")
+	}
+
+	var campaign Campaign
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url("campaigns"),
+		BodyModel:     cfg,
+		ResponseModel: &campaign,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &campaign, nil
+}
+
+type UpdateCampaignConfig struct {
+	CampaignId      string                         `json:"-"`
+	Recipients      *CampaignRecipientsConfig      `json:"recipients,omitempty"`
+	Settings        *CampaignSettingsConfig        `json:"settings"`
+	Tracking        *CampaignTrackingConfig        `json:"tracking,omitempty"`
+	VariateSettings *CampaignVariateSettingsConfig `json:"variate_settings,omitempty"`
+	RssOpts         *CampaignRssOptsConfig         `json:"rss_opts,omitempty"`
+}
+
+func (service *Service) UpdateCampaign(cfg *UpdateCampaignConfig) (*Campaign, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("UpdateCampaignConfig must not be nil")
+	}
+
+	if cfg.Settings == nil {
+		return nil, errortools.ErrorMessage("Settings not provided")
+	}
+
+	var campaign Campaign
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPatch,
+		Url:           service.url(fmt.Sprintf("campaigns/%s", cfg.CampaignId)),
+		BodyModel:     cfg,
+		ResponseModel: &campaign,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &campaign, nil
+}
+
+type DeleteCampaignConfig struct {
+	CampaignId string
+}
+
+func (service *Service) DeleteCampaign(cfg *DeleteCampaignConfig) *errortools.Error {
+	if cfg == nil {
+		return errortools.ErrorMessage("DeleteCampaignConfig must not be nil")
+	}
+
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodDelete,
+		Url:    service.url(fmt.Sprintf("campaigns/%s", cfg.CampaignId)),
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return e
+	}
+
+	return nil
+}
+
+type ReplicateCampaignConfig struct {
+	CampaignId string
+}
+
+// ReplicateCampaign creates a copy of a campaign and returns the new campaign
+func (service *Service) ReplicateCampaign(cfg *ReplicateCampaignConfig) (*Campaign, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ReplicateCampaignConfig must not be nil")
+	}
+
+	var campaign Campaign
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url(fmt.Sprintf("campaigns/%s/actions/replicate", cfg.CampaignId)),
+		ResponseModel: &campaign,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &campaign, nil
+}
@@ -0,0 +1,89 @@
+package mailchimp
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	"github.com/leapforce-libraries/go_mailchimp/types"
+)
+
+type CampaignAbuseReport struct {
+	Id           int                        `json:"id"`
+	CampaignId   string                     `json:"campaign_id"`
+	ListId       string                     `json:"list_id"`
+	ListIsActive bool                       `json:"list_is_active"`
+	EmailId      string                     `json:"email_id"`
+	EmailAddress string                     `json:"email_address"`
+	MergeFields  map[string]json.RawMessage `json:"merge_fields"`
+	Vip          bool                       `json:"vip"`
+	Date         *types.DateTimeString      `json:"date"`
+	Links        []Link                     `json:"_links"`
+}
+
+type ListCampaignAbuseReportsConfig struct {
+	CampaignId    string
+	Fields        *[]string
+	ExcludeFields *[]string
+	Count         *int64
+}
+
+type ListCampaignAbuseReportsResponse struct {
+	AbuseReports []CampaignAbuseReport `json:"abuse_reports"`
+	CampaignId   string                `json:"campaign_id"`
+	TotalItems   int                   `json:"total_items"`
+	Links        []Link                `json:"_links"`
+}
+
+func (service *Service) ListCampaignAbuseReports(cfg *ListCampaignAbuseReportsConfig) (*[]CampaignAbuseReport, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListCampaignAbuseReportsConfig must not be nil")
+	}
+
+	var abuseReports []CampaignAbuseReport
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var count = countDefault
+	if cfg.Count != nil {
+		count = *cfg.Count
+	}
+	values.Set("count", fmt.Sprintf("%v", count))
+
+	for {
+		var response ListCampaignAbuseReportsResponse
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("reports/%s/abuse-reports?%s", cfg.CampaignId, values.Encode())),
+			ResponseModel: &response,
+		}
+
+		_, _, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		abuseReports = append(abuseReports, response.AbuseReports...)
+
+		if len(abuseReports) >= response.TotalItems {
+			break
+		}
+
+		values.Set("offset", fmt.Sprintf("%v", len(abuseReports)))
+	}
+
+	return &abuseReports, nil
+}
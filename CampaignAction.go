@@ -0,0 +1,209 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	"github.com/leapforce-libraries/go_mailchimp/types"
+)
+
+func (service *Service) campaignAction(campaignId string, action string, bodyModel interface{}) *errortools.Error {
+	requestConfig := go_http.RequestConfig{
+		Method:    http.MethodPost,
+		Url:       service.url(fmt.Sprintf("campaigns/%s/actions/%s", campaignId, action)),
+		BodyModel: bodyModel,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return e
+	}
+
+	return nil
+}
+
+type SendCampaignConfig struct {
+	CampaignId string
+}
+
+func (service *Service) SendCampaign(cfg *SendCampaignConfig) *errortools.Error {
+	if cfg == nil {
+		return errortools.ErrorMessage("SendCampaignConfig must not be nil")
+	}
+
+	return service.campaignAction(cfg.CampaignId, "send", nil)
+}
+
+type CampaignBatchDelivery struct {
+	BatchDelay int `json:"batch_delay"`
+	BatchCount int `json:"batch_count"`
+}
+
+type ScheduleCampaignConfig struct {
+	CampaignId    string
+	ScheduleTime  time.Time
+	Timewarp      *bool
+	BatchDelivery *CampaignBatchDelivery
+}
+
+type scheduleCampaignBody struct {
+	ScheduleTime  string                 `json:"schedule_time"`
+	Timewarp      *bool                  `json:"timewarp,omitempty"`
+	BatchDelivery *CampaignBatchDelivery `json:"batch_delivery,omitempty"`
+}
+
+// ScheduleCampaign schedules a campaign, Mailchimp only accepts schedule times on the quarter-hour (:00, :15, :30 or :45)
+func (service *Service) ScheduleCampaign(cfg *ScheduleCampaignConfig) *errortools.Error {
+	if cfg == nil {
+		return errortools.ErrorMessage("ScheduleCampaignConfig must not be nil")
+	}
+
+	scheduleTime := cfg.ScheduleTime.UTC()
+
+	if scheduleTime.Minute()%15 != 0 || scheduleTime.Second() != 0 || scheduleTime.Nanosecond() != 0 {
+		return errortools.ErrorMessage(fmt.Sprintf("ScheduleTime %s is not on the quarter-hour", scheduleTime.Format(types.DateTimeFormat)))
+	}
+
+	if !scheduleTime.After(time.Now()) {
+		return errortools.ErrorMessage(fmt.Sprintf("ScheduleTime %s is not in the future", scheduleTime.Format(types.DateTimeFormat)))
+	}
+
+	if cfg.Timewarp != nil && *cfg.Timewarp && cfg.BatchDelivery != nil {
+		return errortools.ErrorMessage("Timewarp and BatchDelivery cannot be combined")
+	}
+
+	return service.campaignAction(cfg.CampaignId, "schedule", scheduleCampaignBody{
+		ScheduleTime:  scheduleTime.Format(types.DateTimeFormat),
+		Timewarp:      cfg.Timewarp,
+		BatchDelivery: cfg.BatchDelivery,
+	})
+}
+
+type UnscheduleCampaignConfig struct {
+	CampaignId string
+}
+
+func (service *Service) UnscheduleCampaign(cfg *UnscheduleCampaignConfig) *errortools.Error {
+	if cfg == nil {
+		return errortools.ErrorMessage("UnscheduleCampaignConfig must not be nil")
+	}
+
+	return service.campaignAction(cfg.CampaignId, "unschedule", nil)
+}
+
+type SendTestEmailConfig struct {
+	CampaignId string   `json:"-"`
+	TestEmails []string `json:"test_emails"`
+	SendType   string   `json:"send_type"`
+}
+
+// SendTestEmail sends a test email of a campaign, SendType is either "html" or "plaintext"
+func (service *Service) SendTestEmail(cfg *SendTestEmailConfig) *errortools.Error {
+	if cfg == nil {
+		return errortools.ErrorMessage("SendTestEmailConfig must not be nil")
+	}
+
+	if len(cfg.TestEmails) == 0 {
+		return errortools.ErrorMessage("TestEmails not provided")
+	}
+
+	return service.campaignAction(cfg.CampaignId, "test", cfg)
+}
+
+type PauseRssCampaignConfig struct {
+	CampaignId string
+}
+
+func (service *Service) PauseRssCampaign(cfg *PauseRssCampaignConfig) *errortools.Error {
+	if cfg == nil {
+		return errortools.ErrorMessage("PauseRssCampaignConfig must not be nil")
+	}
+
+	return service.campaignAction(cfg.CampaignId, "pause", nil)
+}
+
+type ResumeRssCampaignConfig struct {
+	CampaignId string
+}
+
+func (service *Service) ResumeRssCampaign(cfg *ResumeRssCampaignConfig) *errortools.Error {
+	if cfg == nil {
+		return errortools.ErrorMessage("ResumeRssCampaignConfig must not be nil")
+	}
+
+	return service.campaignAction(cfg.CampaignId, "resume", nil)
+}
+
+type CancelCampaignSendConfig struct {
+	CampaignId string
+}
+
+func (service *Service) CancelCampaignSend(cfg *CancelCampaignSendConfig) *errortools.Error {
+	if cfg == nil {
+		return errortools.ErrorMessage("CancelCampaignSendConfig must not be nil")
+	}
+
+	return service.campaignAction(cfg.CampaignId, "cancel-send", nil)
+}
+
+type SendChecklist struct {
+	IsReady bool                `json:"is_ready"`
+	Items   []SendChecklistItem `json:"items"`
+	Links   []Link              `json:"_links"`
+}
+
+type SendChecklistItem struct {
+	Type    SendChecklistItemType `json:"type"`
+	Id      int                   `json:"id"`
+	Heading string                `json:"heading"`
+	Details string                `json:"details"`
+}
+
+type SendChecklistItemType string
+
+const (
+	SendChecklistItemTypeSuccess SendChecklistItemType = "success"
+	SendChecklistItemTypeWarning SendChecklistItemType = "warning"
+	SendChecklistItemTypeError   SendChecklistItemType = "error"
+)
+
+// ItemsOfType returns the checklist items of the given type, e.g. all errors that block sending
+func (sendChecklist *SendChecklist) ItemsOfType(itemType SendChecklistItemType) []SendChecklistItem {
+	var items []SendChecklistItem
+
+	for _, item := range sendChecklist.Items {
+		if item.Type == itemType {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+type GetSendChecklistConfig struct {
+	CampaignId string
+}
+
+func (service *Service) GetSendChecklist(cfg *GetSendChecklistConfig) (*SendChecklist, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("GetSendChecklistConfig must not be nil")
+	}
+
+	var sendChecklist SendChecklist
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("campaigns/%s/send-checklist", cfg.CampaignId)),
+		ResponseModel: &sendChecklist,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &sendChecklist, nil
+}
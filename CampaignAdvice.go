@@ -0,0 +1,61 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+)
+
+type CampaignAdvice struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+type ListCampaignAdviceConfig struct {
+	CampaignId    string
+	Fields        *[]string
+	ExcludeFields *[]string
+}
+
+type ListCampaignAdviceResponse struct {
+	Advice     []CampaignAdvice `json:"advice"`
+	CampaignId string           `json:"campaign_id"`
+	TotalItems int              `json:"total_items"`
+	Links      []Link           `json:"_links"`
+}
+
+// ListCampaignAdvice returns Mailchimp's feedback on the performance of a campaign, Type is either negative, positive or neutral
+func (service *Service) ListCampaignAdvice(cfg *ListCampaignAdviceConfig) (*[]CampaignAdvice, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListCampaignAdviceConfig must not be nil")
+	}
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var response ListCampaignAdviceResponse
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("reports/%s/advice?%s", cfg.CampaignId, values.Encode())),
+		ResponseModel: &response,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &response.Advice, nil
+}
@@ -0,0 +1,270 @@
+package mailchimp
+
+import (
+	"sort"
+	"time"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+)
+
+// CampaignMetrics holds campaign rates as fractions (0.25 = 25%)
+type CampaignMetrics struct {
+	OpenRate   float64
+	ClickRate  float64
+	BounceRate float64
+	UnsubRate  float64
+	AbuseRate  float64
+}
+
+const campaignMetricsCount int = 5
+
+func (metrics CampaignMetrics) values() [campaignMetricsCount]float64 {
+	return [campaignMetricsCount]float64{metrics.OpenRate, metrics.ClickRate, metrics.BounceRate, metrics.UnsubRate, metrics.AbuseRate}
+}
+
+func campaignMetricsOf(values [campaignMetricsCount]float64) CampaignMetrics {
+	return CampaignMetrics{
+		OpenRate:   values[0],
+		ClickRate:  values[1],
+		BounceRate: values[2],
+		UnsubRate:  values[3],
+		AbuseRate:  values[4],
+	}
+}
+
+func (metrics CampaignMetrics) minus(other CampaignMetrics) CampaignMetrics {
+	a, b := metrics.values(), other.values()
+
+	var values [campaignMetricsCount]float64
+	for i := range values {
+		values[i] = a[i] - b[i]
+	}
+
+	return campaignMetricsOf(values)
+}
+
+// CampaignMetricTrend is a linear trend of a metric, with Slope expressed per day since the first send time
+type CampaignMetricTrend struct {
+	Slope     float64
+	Intercept float64
+}
+
+type CampaignMetricsTrend struct {
+	OpenRate   CampaignMetricTrend
+	ClickRate  CampaignMetricTrend
+	BounceRate CampaignMetricTrend
+	UnsubRate  CampaignMetricTrend
+	AbuseRate  CampaignMetricTrend
+}
+
+// CampaignBenchmark compares a campaign with the industry, its list and the other campaigns.
+// List stats only provide open and click rates, so List and VsList hold no bounce, unsubscribe and abuse rate.
+// ListUnsubscribesPerMonth is the list's average number of unsubscribes per month, which is a count rather than a rate.
+// PercentileRank is the position (0-100) of the campaign's metric within the benchmarked campaigns, where higher means a higher rate.
+type CampaignBenchmark struct {
+	CampaignId               string
+	CampaignTitle            string
+	SendTime                 *time.Time
+	EmailsSent               int
+	Metrics                  CampaignMetrics
+	Industry                 CampaignMetrics
+	List                     CampaignMetrics
+	ListUnsubscribesPerMonth float64
+	VsIndustry               CampaignMetrics
+	VsList                   CampaignMetrics
+	VsAverage                CampaignMetrics
+	PercentileRank           CampaignMetrics
+}
+
+type CampaignBenchmarkResult struct {
+	Campaigns []CampaignBenchmark
+	Average   CampaignMetrics
+	Trend     CampaignMetricsTrend
+}
+
+// BenchmarkCampaignReports compares a set of campaign reports, campaigns without emails sent are skipped.
+// Campaigns are returned ordered by send time.
+func BenchmarkCampaignReports(campaignReports []CampaignReport) *CampaignBenchmarkResult {
+	var result CampaignBenchmarkResult
+
+	for _, campaignReport := range campaignReports {
+		if campaignReport.EmailsSent == 0 {
+			continue
+		}
+
+		emailsSent := float64(campaignReport.EmailsSent)
+
+		var list = CampaignMetrics{
+			// list open and click rates are percentages
+			OpenRate:  campaignReport.ListStats.OpenRate / 100,
+			ClickRate: campaignReport.ListStats.ClickRate / 100,
+		}
+
+		var benchmark = CampaignBenchmark{
+			CampaignId:    campaignReport.Id,
+			CampaignTitle: campaignReport.CampaignTitle,
+			SendTime:      campaignReport.SendTime.ValuePtr(),
+			EmailsSent:    campaignReport.EmailsSent,
+			Metrics: CampaignMetrics{
+				OpenRate:   campaignReport.Opens.OpenRate,
+				ClickRate:  campaignReport.Clicks.ClickRate,
+				BounceRate: float64(campaignReport.Bounces.HardBounces+campaignReport.Bounces.SoftBounces) / emailsSent,
+				UnsubRate:  float64(campaignReport.Unsubscribed) / emailsSent,
+				AbuseRate:  float64(campaignReport.AbuseReports) / emailsSent,
+			},
+			Industry: CampaignMetrics{
+				OpenRate:   campaignReport.IndustryStats.OpenRate,
+				ClickRate:  campaignReport.IndustryStats.ClickRate,
+				BounceRate: campaignReport.IndustryStats.BounceRate,
+				UnsubRate:  campaignReport.IndustryStats.UnsubRate,
+				AbuseRate:  campaignReport.IndustryStats.AbuseRate,
+			},
+			List:                     list,
+			ListUnsubscribesPerMonth: campaignReport.ListStats.UnsubRate,
+		}
+
+		benchmark.VsIndustry = benchmark.Metrics.minus(benchmark.Industry)
+		benchmark.VsList = benchmark.Metrics.minus(list)
+		benchmark.VsList.BounceRate = 0
+		benchmark.VsList.UnsubRate = 0
+		benchmark.VsList.AbuseRate = 0
+
+		result.Campaigns = append(result.Campaigns, benchmark)
+	}
+
+	if len(result.Campaigns) == 0 {
+		return &result
+	}
+
+	sort.SliceStable(result.Campaigns, func(i, j int) bool {
+		a, b := result.Campaigns[i].SendTime, result.Campaigns[j].SendTime
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return a.Before(*b)
+	})
+
+	// averages
+	var sums [campaignMetricsCount]float64
+	for _, benchmark := range result.Campaigns {
+		values := benchmark.Metrics.values()
+		for i := range sums {
+			sums[i] += values[i]
+		}
+	}
+	for i := range sums {
+		sums[i] /= float64(len(result.Campaigns))
+	}
+	result.Average = campaignMetricsOf(sums)
+
+	// percentile ranks
+	for i := range result.Campaigns {
+		values := result.Campaigns[i].Metrics.values()
+
+		var ranks [campaignMetricsCount]float64
+		for m := range ranks {
+			var below, equal float64
+			for _, other := range result.Campaigns {
+				otherValue := other.Metrics.values()[m]
+				if otherValue < values[m] {
+					below++
+				} else if otherValue == values[m] {
+					equal++
+				}
+			}
+			ranks[m] = 100 * (below + 0.5*equal) / float64(len(result.Campaigns))
+		}
+
+		result.Campaigns[i].PercentileRank = campaignMetricsOf(ranks)
+		result.Campaigns[i].VsAverage = result.Campaigns[i].Metrics.minus(result.Average)
+	}
+
+	result.Trend = campaignMetricsTrend(result.Campaigns)
+
+	return &result
+}
+
+// campaignMetricsTrend fits a least squares line through each metric against days since the first send time
+func campaignMetricsTrend(benchmarks []CampaignBenchmark) CampaignMetricsTrend {
+	var first *time.Time
+	var xs []float64
+	var ys [][campaignMetricsCount]float64
+
+	for _, benchmark := range benchmarks {
+		if benchmark.SendTime == nil {
+			continue
+		}
+		if first == nil {
+			first = benchmark.SendTime
+		}
+
+		xs = append(xs, benchmark.SendTime.Sub(*first).Hours()/24)
+		ys = append(ys, benchmark.Metrics.values())
+	}
+
+	var trends [campaignMetricsCount]CampaignMetricTrend
+
+	if len(xs) < 2 {
+		return CampaignMetricsTrend{}
+	}
+
+	n := float64(len(xs))
+
+	var sumX, sumXX float64
+	for _, x := range xs {
+		sumX += x
+		sumXX += x * x
+	}
+	denominator := n*sumXX - sumX*sumX
+
+	for m := range trends {
+		var sumY, sumXY float64
+		for i, x := range xs {
+			sumY += ys[i][m]
+			sumXY += x * ys[i][m]
+		}
+
+		if denominator == 0 {
+			trends[m] = CampaignMetricTrend{Intercept: sumY / n}
+			continue
+		}
+
+		slope := (n*sumXY - sumX*sumY) / denominator
+		trends[m] = CampaignMetricTrend{
+			Slope:     slope,
+			Intercept: (sumY - slope*sumX) / n,
+		}
+	}
+
+	return CampaignMetricsTrend{
+		OpenRate:   trends[0],
+		ClickRate:  trends[1],
+		BounceRate: trends[2],
+		UnsubRate:  trends[3],
+		AbuseRate:  trends[4],
+	}
+}
+
+type BenchmarkCampaignsConfig struct {
+	Type           *CampaignType
+	BeforeSendTime *time.Time
+	SinceSendTime  *time.Time
+}
+
+// BenchmarkCampaigns fetches the campaign reports within a send time window and benchmarks them
+func (service *Service) BenchmarkCampaigns(cfg *BenchmarkCampaignsConfig) (*CampaignBenchmarkResult, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("BenchmarkCampaignsConfig must not be nil")
+	}
+
+	campaignReports, e := service.ListCampaignReports(&ListCampaignReportsConfig{
+		Type:           cfg.Type,
+		BeforeSendTime: cfg.BeforeSendTime,
+		SinceSendTime:  cfg.SinceSendTime,
+	})
+	if e != nil {
+		return nil, e
+	}
+
+	return BenchmarkCampaignReports(*campaignReports), nil
+}
@@ -0,0 +1,129 @@
+package mailchimp
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestBenchmarkCampaignReports(t *testing.T) {
+	const delta = 1e-9
+
+	tests := []struct {
+		name           string
+		reports        string
+		wantIds        []string
+		wantVsIndustry *CampaignMetrics
+		wantVsList     *CampaignMetrics
+		wantList       *CampaignMetrics
+		wantListUnsubs float64
+		wantOpenRanks  []float64
+		wantOpenTrend  CampaignMetricTrend
+	}{
+		{
+			name: "deltas",
+			reports: `[{
+				"id": "a",
+				"emails_sent": 100,
+				"abuse_reports": 1,
+				"unsubscribed": 1,
+				"send_time": "2024-01-01T10:00:00+00:00",
+				"bounces": {"hard_bounces": 2, "soft_bounces": 3},
+				"opens": {"open_rate": 0.3},
+				"clicks": {"click_rate": 0.05},
+				"industry_stats": {"open_rate": 0.2, "click_rate": 0.02, "bounce_rate": 0.01, "unsub_rate": 0.005, "abuse_rate": 0.001},
+				"list_stats": {"sub_rate": 30, "unsub_rate": 12, "open_rate": 25, "click_rate": 4}
+			}]`,
+			wantIds:        []string{"a"},
+			wantVsIndustry: &CampaignMetrics{OpenRate: 0.1, ClickRate: 0.03, BounceRate: 0.04, UnsubRate: 0.005, AbuseRate: 0.009},
+			wantVsList:     &CampaignMetrics{OpenRate: 0.05, ClickRate: 0.01},
+			wantList:       &CampaignMetrics{OpenRate: 0.25, ClickRate: 0.04},
+			wantListUnsubs: 12,
+			wantOpenRanks:  []float64{50},
+		},
+		{
+			name: "percentile ranks and trend",
+			reports: `[
+				{"id": "c", "emails_sent": 10, "send_time": "2024-01-03T10:00:00+00:00", "opens": {"open_rate": 0.3}},
+				{"id": "a", "emails_sent": 10, "send_time": "2024-01-01T10:00:00+00:00", "opens": {"open_rate": 0.1}},
+				{"id": "skipped", "emails_sent": 0, "send_time": "2024-01-02T10:00:00+00:00", "opens": {"open_rate": 0.9}},
+				{"id": "b", "emails_sent": 10, "send_time": "2024-01-02T10:00:00+00:00", "opens": {"open_rate": 0.2}}
+			]`,
+			wantIds:       []string{"a", "b", "c"},
+			wantOpenRanks: []float64{100.0 / 6, 50, 500.0 / 6},
+			wantOpenTrend: CampaignMetricTrend{Slope: 0.1, Intercept: 0.1},
+		},
+		{
+			name: "empty send time is left out of the trend",
+			reports: `[
+				{"id": "b", "emails_sent": 10, "send_time": "2024-01-02T10:00:00+00:00", "opens": {"open_rate": 0.2}},
+				{"id": "blank", "emails_sent": 10, "send_time": "", "opens": {"open_rate": 0.5}},
+				{"id": "a", "emails_sent": 10, "send_time": "2024-01-01T10:00:00+00:00", "opens": {"open_rate": 0.1}}
+			]`,
+			wantIds:       []string{"a", "b", "blank"},
+			wantOpenRanks: []float64{100.0 / 6, 50, 500.0 / 6},
+			wantOpenTrend: CampaignMetricTrend{Slope: 0.1, Intercept: 0.1},
+		},
+		{
+			name: "ties share the mid rank",
+			reports: `[
+				{"id": "a", "emails_sent": 10, "send_time": "2024-01-01T10:00:00+00:00", "opens": {"open_rate": 0.2}},
+				{"id": "b", "emails_sent": 10, "send_time": "2024-01-01T10:00:00+00:00", "opens": {"open_rate": 0.2}}
+			]`,
+			wantIds:       []string{"a", "b"},
+			wantOpenRanks: []float64{50, 50},
+			wantOpenTrend: CampaignMetricTrend{Intercept: 0.2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var reports []CampaignReport
+			if err := json.Unmarshal([]byte(tt.reports), &reports); err != nil {
+				t.Fatal(err)
+			}
+
+			result := BenchmarkCampaignReports(reports)
+
+			if len(result.Campaigns) != len(tt.wantIds) {
+				t.Fatalf("got %v campaigns, want %v", len(result.Campaigns), len(tt.wantIds))
+			}
+
+			for i, benchmark := range result.Campaigns {
+				if benchmark.CampaignId != tt.wantIds[i] {
+					t.Errorf("campaign %v: got id %s, want %s", i, benchmark.CampaignId, tt.wantIds[i])
+				}
+				if rank := benchmark.PercentileRank.OpenRate; math.Abs(rank-tt.wantOpenRanks[i]) > delta {
+					t.Errorf("campaign %s: got open rate rank %v, want %v", benchmark.CampaignId, rank, tt.wantOpenRanks[i])
+				}
+			}
+
+			first := result.Campaigns[0]
+
+			checkMetrics := func(label string, got CampaignMetrics, want *CampaignMetrics) {
+				if want == nil {
+					return
+				}
+				g, w := got.values(), want.values()
+				for i := range g {
+					if math.Abs(g[i]-w[i]) > delta {
+						t.Errorf("%s: got %+v, want %+v", label, got, *want)
+						return
+					}
+				}
+			}
+			checkMetrics("VsIndustry", first.VsIndustry, tt.wantVsIndustry)
+			checkMetrics("VsList", first.VsList, tt.wantVsList)
+			checkMetrics("List", first.List, tt.wantList)
+
+			if first.ListUnsubscribesPerMonth != tt.wantListUnsubs {
+				t.Errorf("got ListUnsubscribesPerMonth %v, want %v", first.ListUnsubscribesPerMonth, tt.wantListUnsubs)
+			}
+
+			trend := result.Trend.OpenRate
+			if math.Abs(trend.Slope-tt.wantOpenTrend.Slope) > delta || math.Abs(trend.Intercept-tt.wantOpenTrend.Intercept) > delta {
+				t.Errorf("got open rate trend %+v, want %+v", trend, tt.wantOpenTrend)
+			}
+		})
+	}
+}
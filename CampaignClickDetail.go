@@ -0,0 +1,191 @@
+package mailchimp
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	"github.com/leapforce-libraries/go_mailchimp/types"
+)
+
+type CampaignClickDetail struct {
+	Id                    string                `json:"id"`
+	Url                   string                `json:"url"`
+	TotalClicks           int                   `json:"total_clicks"`
+	ClickPercentage       float64               `json:"click_percentage"`
+	UniqueClicks          int                   `json:"unique_clicks"`
+	UniqueClickPercentage float64               `json:"unique_click_percentage"`
+	LastClick             *types.DateTimeString `json:"last_click"`
+	AbSplit               struct {
+		A struct {
+			TotalClicksA           int     `json:"total_clicks_a"`
+			ClickPercentageA       float64 `json:"click_percentage_a"`
+			UniqueClicksA          int     `json:"unique_clicks_a"`
+			UniqueClickPercentageA float64 `json:"unique_click_percentage_a"`
+		} `json:"a"`
+		B struct {
+			TotalClicksB           int     `json:"total_clicks_b"`
+			ClickPercentageB       float64 `json:"click_percentage_b"`
+			UniqueClicksB          int     `json:"unique_clicks_b"`
+			UniqueClickPercentageB float64 `json:"unique_click_percentage_b"`
+		} `json:"b"`
+	} `json:"ab_split"`
+	CampaignId string `json:"campaign_id"`
+	Links      []Link `json:"_links"`
+}
+
+type ListCampaignClickDetailsConfig struct {
+	CampaignId    string
+	Fields        *[]string
+	ExcludeFields *[]string
+	Count         *int64
+	SortField     *string
+	SortDir       *string
+}
+
+type ListCampaignClickDetailsResponse struct {
+	UrlsClicked []CampaignClickDetail `json:"urls_clicked"`
+	CampaignId  string                `json:"campaign_id"`
+	TotalItems  int                   `json:"total_items"`
+	Links       []Link                `json:"_links"`
+}
+
+func (service *Service) ListCampaignClickDetails(cfg *ListCampaignClickDetailsConfig) (*[]CampaignClickDetail, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListCampaignClickDetailsConfig must not be nil")
+	}
+
+	var clickDetails []CampaignClickDetail
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var count = countDefault
+	if cfg.Count != nil {
+		count = *cfg.Count
+	}
+	values.Set("count", fmt.Sprintf("%v", count))
+
+	if cfg.SortField != nil {
+		values.Set("sort_field", *cfg.SortField)
+	}
+
+	if cfg.SortDir != nil {
+		values.Set("sort_dir", *cfg.SortDir)
+	}
+
+	for {
+		var response ListCampaignClickDetailsResponse
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("reports/%s/click-details?%s", cfg.CampaignId, values.Encode())),
+			ResponseModel: &response,
+		}
+
+		_, _, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		clickDetails = append(clickDetails, response.UrlsClicked...)
+
+		if len(clickDetails) >= response.TotalItems {
+			break
+		}
+
+		values.Set("offset", fmt.Sprintf("%v", len(clickDetails)))
+	}
+
+	return &clickDetails, nil
+}
+
+type CampaignClickDetailMember struct {
+	EmailId       string                     `json:"email_id"`
+	EmailAddress  string                     `json:"email_address"`
+	MergeFields   map[string]json.RawMessage `json:"merge_fields"`
+	Clicks        int                        `json:"clicks"`
+	Vip           bool                       `json:"vip"`
+	ContactStatus string                     `json:"contact_status"`
+	CampaignId    string                     `json:"campaign_id"`
+	UrlId         string                     `json:"url_id"`
+	ListId        string                     `json:"list_id"`
+	ListIsActive  bool                       `json:"list_is_active"`
+	Links         []Link                     `json:"_links"`
+}
+
+type ListCampaignClickDetailMembersConfig struct {
+	CampaignId    string
+	LinkId        string
+	Fields        *[]string
+	ExcludeFields *[]string
+	Count         *int64
+}
+
+type ListCampaignClickDetailMembersResponse struct {
+	Members    []CampaignClickDetailMember `json:"members"`
+	CampaignId string                      `json:"campaign_id"`
+	TotalItems int                         `json:"total_items"`
+	Links      []Link                      `json:"_links"`
+}
+
+// ListCampaignClickDetailMembers returns the members that clicked a link, with the number of times they clicked it
+func (service *Service) ListCampaignClickDetailMembers(cfg *ListCampaignClickDetailMembersConfig) (*[]CampaignClickDetailMember, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListCampaignClickDetailMembersConfig must not be nil")
+	}
+
+	var members []CampaignClickDetailMember
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var count = countDefault
+	if cfg.Count != nil {
+		count = *cfg.Count
+	}
+	values.Set("count", fmt.Sprintf("%v", count))
+
+	for {
+		var response ListCampaignClickDetailMembersResponse
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("reports/%s/click-details/%s/members?%s", cfg.CampaignId, cfg.LinkId, values.Encode())),
+			ResponseModel: &response,
+		}
+
+		_, _, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		members = append(members, response.Members...)
+
+		if len(members) >= response.TotalItems {
+			break
+		}
+
+		values.Set("offset", fmt.Sprintf("%v", len(members)))
+	}
+
+	return &members, nil
+}
@@ -0,0 +1,137 @@
+package mailchimp
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+)
+
+type CampaignContent struct {
+	VariateContents []CampaignVariateContent `json:"variate_contents"`
+	PlainText       string                   `json:"plain_text"`
+	Html            string                   `json:"html"`
+	ArchiveHtml     string                   `json:"archive_html"`
+	Links           []Link                   `json:"_links"`
+}
+
+type CampaignVariateContent struct {
+	ContentLabel string `json:"content_label"`
+	PlainText    string `json:"plain_text"`
+	Html         string `json:"html"`
+}
+
+type CampaignContentTemplate struct {
+	Id       int                `json:"id"`
+	Sections *map[string]string `json:"sections,omitempty"`
+}
+
+type CampaignContentArchive struct {
+	ArchiveContent string  `json:"archive_content"`
+	ArchiveType    *string `json:"archive_type,omitempty"`
+}
+
+// NewCampaignContentArchive base64 encodes the archive file content, archiveType is one of zip, tar.gz, tar.bz2, tar, tgz or tbz
+func NewCampaignContentArchive(content []byte, archiveType *string) *CampaignContentArchive {
+	return &CampaignContentArchive{
+		ArchiveContent: base64.StdEncoding.EncodeToString(content),
+		ArchiveType:    archiveType,
+	}
+}
+
+type GetCampaignContentConfig struct {
+	CampaignId    string
+	Fields        *[]string
+	ExcludeFields *[]string
+}
+
+func (service *Service) GetCampaignContent(cfg *GetCampaignContentConfig) (*CampaignContent, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("GetCampaignContentConfig must not be nil")
+	}
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var campaignContent CampaignContent
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("campaigns/%s/content?%s", cfg.CampaignId, values.Encode())),
+		ResponseModel: &campaignContent,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &campaignContent, nil
+}
+
+type SetCampaignVariateContent struct {
+	ContentLabel string                   `json:"content_label"`
+	PlainText    *string                  `json:"plain_text,omitempty"`
+	Html         *string                  `json:"html,omitempty"`
+	Url          *string                  `json:"url,omitempty"`
+	Template     *CampaignContentTemplate `json:"template,omitempty"`
+	Archive      *CampaignContentArchive  `json:"archive,omitempty"`
+}
+
+// SetCampaignContentConfig takes the content from exactly one of Html, Url, Template or Archive,
+// or from VariateContents for multivariate campaigns
+type SetCampaignContentConfig struct {
+	CampaignId      string                       `json:"-"`
+	PlainText       *string                      `json:"plain_text,omitempty"`
+	Html            *string                      `json:"html,omitempty"`
+	Url             *string                      `json:"url,omitempty"`
+	Template        *CampaignContentTemplate     `json:"template,omitempty"`
+	Archive         *CampaignContentArchive      `json:"archive,omitempty"`
+	VariateContents *[]SetCampaignVariateContent `json:"variate_contents,omitempty"`
+}
+
+func (service *Service) SetCampaignContent(cfg *SetCampaignContentConfig) (*CampaignContent, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("SetCampaignContentConfig must not be nil")
+	}
+
+	var sources = 0
+	for _, isSet := range []bool{cfg.Html != nil, cfg.Url != nil, cfg.Template != nil, cfg.Archive != nil, cfg.VariateContents != nil} {
+		if isSet {
+			sources++
+		}
+	}
+	if sources > 1 {
+		return nil, errortools.ErrorMessage("Only one of Html, Url, Template, Archive or VariateContents can be provided")
+	}
+	if sources == 0 && cfg.PlainText == nil {
+		return nil, errortools.ErrorMessage("No content provided")
+	}
+
+	var campaignContent CampaignContent
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPut,
+		Url:           service.url(fmt.Sprintf("campaigns/%s/content", cfg.CampaignId)),
+		BodyModel:     cfg,
+		ResponseModel: &campaignContent,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &campaignContent, nil
+}
@@ -0,0 +1,91 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+)
+
+type CampaignDomainPerformance struct {
+	Domain     string  `json:"domain"`
+	EmailsSent int     `json:"emails_sent"`
+	Bounces    int     `json:"bounces"`
+	Opens      int     `json:"opens"`
+	Clicks     int     `json:"clicks"`
+	Unsubs     int     `json:"unsubs"`
+	Delivered  int     `json:"delivered"`
+	EmailsPct  float64 `json:"emails_pct"`
+	BouncesPct float64 `json:"bounces_pct"`
+	OpensPct   float64 `json:"opens_pct"`
+	ClicksPct  float64 `json:"clicks_pct"`
+	UnsubsPct  float64 `json:"unsubs_pct"`
+}
+
+type ListCampaignDomainPerformanceConfig struct {
+	CampaignId    string
+	Fields        *[]string
+	ExcludeFields *[]string
+	Count         *int64
+}
+
+type ListCampaignDomainPerformanceResponse struct {
+	Domains    []CampaignDomainPerformance `json:"domains"`
+	TotalSent  int                         `json:"total_sent"`
+	CampaignId string                      `json:"campaign_id"`
+	TotalItems int                         `json:"total_items"`
+	Links      []Link                      `json:"_links"`
+}
+
+// ListCampaignDomainPerformance returns the performance of a campaign per email domain
+func (service *Service) ListCampaignDomainPerformance(cfg *ListCampaignDomainPerformanceConfig) (*[]CampaignDomainPerformance, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListCampaignDomainPerformanceConfig must not be nil")
+	}
+
+	var domains []CampaignDomainPerformance
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var count = countDefault
+	if cfg.Count != nil {
+		count = *cfg.Count
+	}
+	values.Set("count", fmt.Sprintf("%v", count))
+
+	for {
+		var response ListCampaignDomainPerformanceResponse
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("reports/%s/domain-performance?%s", cfg.CampaignId, values.Encode())),
+			ResponseModel: &response,
+		}
+
+		_, _, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		domains = append(domains, response.Domains...)
+
+		if len(domains) >= response.TotalItems {
+			break
+		}
+
+		values.Set("offset", fmt.Sprintf("%v", len(domains)))
+	}
+
+	return &domains, nil
+}
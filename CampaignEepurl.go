@@ -0,0 +1,84 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	"github.com/leapforce-libraries/go_mailchimp/types"
+)
+
+type CampaignEepurl struct {
+	Twitter struct {
+		Tweets     int                   `json:"tweets"`
+		FirstTweet *types.DateTimeString `json:"first_tweet"`
+		LastTweet  *types.DateTimeString `json:"last_tweet"`
+		Retweets   int                   `json:"retweets"`
+		Statuses   []struct {
+			Status     string                `json:"status"`
+			ScreenName string                `json:"screen_name"`
+			StatusId   string                `json:"status_id"`
+			Datetime   *types.DateTimeString `json:"datetime"`
+			IsRetweet  bool                  `json:"is_retweet"`
+		} `json:"statuses"`
+	} `json:"twitter"`
+	Clicks struct {
+		Clicks     int                   `json:"clicks"`
+		FirstClick *types.DateTimeString `json:"first_click"`
+		LastClick  *types.DateTimeString `json:"last_click"`
+		Locations  []struct {
+			Country string `json:"country"`
+			Region  string `json:"region"`
+		} `json:"locations"`
+	} `json:"clicks"`
+	Referrers []struct {
+		Referrer   string                `json:"referrer"`
+		Clicks     int                   `json:"clicks"`
+		FirstClick *types.DateTimeString `json:"first_click"`
+		LastClick  *types.DateTimeString `json:"last_click"`
+	} `json:"referrers"`
+	Eepurl     string `json:"eepurl"`
+	CampaignId string `json:"campaign_id"`
+	Links      []Link `json:"_links"`
+}
+
+type GetCampaignEepurlConfig struct {
+	CampaignId    string
+	Fields        *[]string
+	ExcludeFields *[]string
+}
+
+// GetCampaignEepurl returns the Twitter and referrer activity of the campaign's eepurl short link
+func (service *Service) GetCampaignEepurl(cfg *GetCampaignEepurlConfig) (*CampaignEepurl, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("GetCampaignEepurlConfig must not be nil")
+	}
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var eepurl CampaignEepurl
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("reports/%s/eepurl?%s", cfg.CampaignId, values.Encode())),
+		ResponseModel: &eepurl,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &eepurl, nil
+}
@@ -0,0 +1,100 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	"github.com/leapforce-libraries/go_mailchimp/types"
+)
+
+type CampaignEmailActivity struct {
+	CampaignId   string                      `json:"campaign_id"`
+	ListId       string                      `json:"list_id"`
+	ListIsActive bool                        `json:"list_is_active"`
+	EmailId      string                      `json:"email_id"`
+	EmailAddress string                      `json:"email_address"`
+	Activity     []CampaignEmailActivityItem `json:"activity"`
+	Links        []Link                      `json:"_links"`
+}
+
+// CampaignEmailActivityItem is a single open, click or bounce, Type holds the bounce type and Url the clicked url
+type CampaignEmailActivityItem struct {
+	Action    string                `json:"action"`
+	Type      string                `json:"type"`
+	Timestamp *types.DateTimeString `json:"timestamp"`
+	Url       string                `json:"url"`
+	Ip        string                `json:"ip"`
+}
+
+type ListCampaignEmailActivityConfig struct {
+	CampaignId    string
+	Fields        *[]string
+	ExcludeFields *[]string
+	Count         *int64
+	Since         *time.Time
+}
+
+type ListCampaignEmailActivityResponse struct {
+	Emails     []CampaignEmailActivity `json:"emails"`
+	CampaignId string                  `json:"campaign_id"`
+	TotalItems int                     `json:"total_items"`
+	Links      []Link                  `json:"_links"`
+}
+
+func (service *Service) ListCampaignEmailActivity(cfg *ListCampaignEmailActivityConfig) (*[]CampaignEmailActivity, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListCampaignEmailActivityConfig must not be nil")
+	}
+
+	var emailActivity []CampaignEmailActivity
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var count = countDefault
+	if cfg.Count != nil {
+		count = *cfg.Count
+	}
+	values.Set("count", fmt.Sprintf("%v", count))
+
+	if cfg.Since != nil {
+		values.Set("since", (*cfg.Since).Format(types.DateTimeFormat))
+	}
+
+	for {
+		var response ListCampaignEmailActivityResponse
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("reports/%s/email-activity?%s", cfg.CampaignId, values.Encode())),
+			ResponseModel: &response,
+		}
+
+		_, _, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		emailActivity = append(emailActivity, response.Emails...)
+
+		if len(emailActivity) >= response.TotalItems {
+			break
+		}
+
+		values.Set("offset", fmt.Sprintf("%v", len(emailActivity)))
+	}
+
+	return &emailActivity, nil
+}
@@ -0,0 +1,178 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	"github.com/leapforce-libraries/go_mailchimp/types"
+)
+
+type CampaignFeedback struct {
+	FeedbackId int                   `json:"feedback_id"`
+	ParentId   int                   `json:"parent_id"`
+	BlockId    int                   `json:"block_id"`
+	Message    string                `json:"message"`
+	IsComplete bool                  `json:"is_complete"`
+	CreatedBy  string                `json:"created_by"`
+	CreatedAt  *types.DateTimeString `json:"created_at"`
+	UpdatedAt  *types.DateTimeString `json:"updated_at"`
+	Source     string                `json:"source"`
+	CampaignId string                `json:"campaign_id"`
+	Links      []Link                `json:"_links"`
+}
+
+type ListCampaignFeedbackConfig struct {
+	CampaignId    string
+	Fields        *[]string
+	ExcludeFields *[]string
+}
+
+type ListCampaignFeedbackResponse struct {
+	Feedback   []CampaignFeedback `json:"feedback"`
+	CampaignId string             `json:"campaign_id"`
+	TotalItems int                `json:"total_items"`
+	Links      []Link             `json:"_links"`
+}
+
+func (service *Service) ListCampaignFeedback(cfg *ListCampaignFeedbackConfig) (*[]CampaignFeedback, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListCampaignFeedbackConfig must not be nil")
+	}
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var response ListCampaignFeedbackResponse
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("campaigns/%s/feedback?%s", cfg.CampaignId, values.Encode())),
+		ResponseModel: &response,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &response.Feedback, nil
+}
+
+type GetCampaignFeedbackConfig struct {
+	CampaignId string
+	FeedbackId int
+}
+
+func (service *Service) GetCampaignFeedback(cfg *GetCampaignFeedbackConfig) (*CampaignFeedback, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("GetCampaignFeedbackConfig must not be nil")
+	}
+
+	var feedback CampaignFeedback
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("campaigns/%s/feedback/%v", cfg.CampaignId, cfg.FeedbackId)),
+		ResponseModel: &feedback,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &feedback, nil
+}
+
+type CreateCampaignFeedbackConfig struct {
+	CampaignId string `json:"-"`
+	Message    string `json:"message"`
+	BlockId    *int   `json:"block_id,omitempty"`
+	IsComplete *bool  `json:"is_complete,omitempty"`
+}
+
+func (service *Service) CreateCampaignFeedback(cfg *CreateCampaignFeedbackConfig) (*CampaignFeedback, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("CreateCampaignFeedbackConfig must not be nil")
+	}
+
+	var feedback CampaignFeedback
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url(fmt.Sprintf("campaigns/%s/feedback", cfg.CampaignId)),
+		BodyModel:     cfg,
+		ResponseModel: &feedback,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &feedback, nil
+}
+
+type UpdateCampaignFeedbackConfig struct {
+	CampaignId string  `json:"-"`
+	FeedbackId int     `json:"-"`
+	Message    *string `json:"message,omitempty"`
+	BlockId    *int    `json:"block_id,omitempty"`
+	IsComplete *bool   `json:"is_complete,omitempty"`
+}
+
+func (service *Service) UpdateCampaignFeedback(cfg *UpdateCampaignFeedbackConfig) (*CampaignFeedback, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("UpdateCampaignFeedbackConfig must not be nil")
+	}
+
+	var feedback CampaignFeedback
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPatch,
+		Url:           service.url(fmt.Sprintf("campaigns/%s/feedback/%v", cfg.CampaignId, cfg.FeedbackId)),
+		BodyModel:     cfg,
+		ResponseModel: &feedback,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &feedback, nil
+}
+
+type DeleteCampaignFeedbackConfig struct {
+	CampaignId string
+	FeedbackId int
+}
+
+func (service *Service) DeleteCampaignFeedback(cfg *DeleteCampaignFeedbackConfig) *errortools.Error {
+	if cfg == nil {
+		return errortools.ErrorMessage("DeleteCampaignFeedbackConfig must not be nil")
+	}
+
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodDelete,
+		Url:    service.url(fmt.Sprintf("campaigns/%s/feedback/%v", cfg.CampaignId, cfg.FeedbackId)),
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return e
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+)
+
+type CampaignFolder struct {
+	Name  string `json:"name"`
+	Id    string `json:"id"`
+	Count int    `json:"count"`
+	Links []Link `json:"_links"`
+}
+
+type ListCampaignFoldersConfig struct {
+	Fields        *[]string
+	ExcludeFields *[]string
+	Count         *int64
+}
+
+type ListCampaignFoldersResponse struct {
+	Folders    []CampaignFolder `json:"folders"`
+	TotalItems int              `json:"total_items"`
+	Links      []Link           `json:"_links"`
+}
+
+func (service *Service) ListCampaignFolders(cfg *ListCampaignFoldersConfig) (*[]CampaignFolder, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListCampaignFoldersConfig must not be nil")
+	}
+
+	var folders []CampaignFolder
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var count = countDefault
+	if cfg.Count != nil {
+		count = *cfg.Count
+	}
+	values.Set("count", fmt.Sprintf("%v", count))
+
+	for {
+		var response ListCampaignFoldersResponse
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("campaign-folders?%s", values.Encode())),
+			ResponseModel: &response,
+		}
+
+		_, _, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		folders = append(folders, response.Folders...)
+
+		if len(folders) >= response.TotalItems {
+			break
+		}
+
+		values.Set("offset", fmt.Sprintf("%v", len(folders)))
+	}
+
+	return &folders, nil
+}
+
+type GetCampaignFolderConfig struct {
+	FolderId string
+}
+
+func (service *Service) GetCampaignFolder(cfg *GetCampaignFolderConfig) (*CampaignFolder, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("GetCampaignFolderConfig must not be nil")
+	}
+
+	var folder CampaignFolder
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("campaign-folders/%s", cfg.FolderId)),
+		ResponseModel: &folder,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &folder, nil
+}
+
+type CreateCampaignFolderConfig struct {
+	Name string `json:"name"`
+}
+
+func (service *Service) CreateCampaignFolder(cfg *CreateCampaignFolderConfig) (*CampaignFolder, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("CreateCampaignFolderConfig must not be nil")
+	}
+
+	var folder CampaignFolder
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url("campaign-folders"),
+		BodyModel:     cfg,
+		ResponseModel: &folder,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &folder, nil
+}
+
+type UpdateCampaignFolderConfig struct {
+	FolderId string `json:"-"`
+	Name     string `json:"name"`
+}
+
+func (service *Service) UpdateCampaignFolder(cfg *UpdateCampaignFolderConfig) (*CampaignFolder, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("UpdateCampaignFolderConfig must not be nil")
+	}
+
+	var folder CampaignFolder
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPatch,
+		Url:           service.url(fmt.Sprintf("campaign-folders/%s", cfg.FolderId)),
+		BodyModel:     cfg,
+		ResponseModel: &folder,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &folder, nil
+}
+
+type DeleteCampaignFolderConfig struct {
+	FolderId string
+}
+
+func (service *Service) DeleteCampaignFolder(cfg *DeleteCampaignFolderConfig) *errortools.Error {
+	if cfg == nil {
+		return errortools.ErrorMessage("DeleteCampaignFolderConfig must not be nil")
+	}
+
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodDelete,
+		Url:    service.url(fmt.Sprintf("campaign-folders/%s", cfg.FolderId)),
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return e
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+)
+
+type CampaignLocation struct {
+	CountryCode string `json:"country_code"`
+	Region      string `json:"region"`
+	RegionName  string `json:"region_name"`
+	Opens       int    `json:"opens"`
+}
+
+type ListCampaignLocationsConfig struct {
+	CampaignId    string
+	Fields        *[]string
+	ExcludeFields *[]string
+	Count         *int64
+}
+
+type ListCampaignLocationsResponse struct {
+	Locations  []CampaignLocation `json:"locations"`
+	CampaignId string             `json:"campaign_id"`
+	TotalItems int                `json:"total_items"`
+	Links      []Link             `json:"_links"`
+}
+
+// ListCampaignLocations returns the number of opens of a campaign per region
+func (service *Service) ListCampaignLocations(cfg *ListCampaignLocationsConfig) (*[]CampaignLocation, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListCampaignLocationsConfig must not be nil")
+	}
+
+	var locations []CampaignLocation
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var count = countDefault
+	if cfg.Count != nil {
+		count = *cfg.Count
+	}
+	values.Set("count", fmt.Sprintf("%v", count))
+
+	for {
+		var response ListCampaignLocationsResponse
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("reports/%s/locations?%s", cfg.CampaignId, values.Encode())),
+			ResponseModel: &response,
+		}
+
+		_, _, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		locations = append(locations, response.Locations...)
+
+		if len(locations) >= response.TotalItems {
+			break
+		}
+
+		values.Set("offset", fmt.Sprintf("%v", len(locations)))
+	}
+
+	return &locations, nil
+}
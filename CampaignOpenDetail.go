@@ -0,0 +1,99 @@
+package mailchimp
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	"github.com/leapforce-libraries/go_mailchimp/types"
+)
+
+type CampaignOpenDetail struct {
+	CampaignId    string                     `json:"campaign_id"`
+	ListId        string                     `json:"list_id"`
+	ListIsActive  bool                       `json:"list_is_active"`
+	ContactStatus string                     `json:"contact_status"`
+	EmailId       string                     `json:"email_id"`
+	EmailAddress  string                     `json:"email_address"`
+	MergeFields   map[string]json.RawMessage `json:"merge_fields"`
+	Vip           bool                       `json:"vip"`
+	OpensCount    int                        `json:"opens_count"`
+	Opens         []struct {
+		Timestamp *types.DateTimeString `json:"timestamp"`
+	} `json:"opens"`
+	Links []Link `json:"_links"`
+}
+
+type ListCampaignOpenDetailsConfig struct {
+	CampaignId    string
+	Fields        *[]string
+	ExcludeFields *[]string
+	Count         *int64
+	Since         *time.Time
+}
+
+type ListCampaignOpenDetailsResponse struct {
+	Members    []CampaignOpenDetail `json:"members"`
+	CampaignId string               `json:"campaign_id"`
+	TotalOpens int                  `json:"total_opens"`
+	TotalItems int                  `json:"total_items"`
+	Links      []Link               `json:"_links"`
+}
+
+func (service *Service) ListCampaignOpenDetails(cfg *ListCampaignOpenDetailsConfig) (*[]CampaignOpenDetail, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListCampaignOpenDetailsConfig must not be nil")
+	}
+
+	var openDetails []CampaignOpenDetail
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var count = countDefault
+	if cfg.Count != nil {
+		count = *cfg.Count
+	}
+	values.Set("count", fmt.Sprintf("%v", count))
+
+	if cfg.Since != nil {
+		values.Set("since", (*cfg.Since).Format(types.DateTimeFormat))
+	}
+
+	for {
+		var response ListCampaignOpenDetailsResponse
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("reports/%s/open-details?%s", cfg.CampaignId, values.Encode())),
+			ResponseModel: &response,
+		}
+
+		_, _, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		openDetails = append(openDetails, response.Members...)
+
+		if len(openDetails) >= response.TotalItems {
+			break
+		}
+
+		values.Set("offset", fmt.Sprintf("%v", len(openDetails)))
+	}
+
+	return &openDetails, nil
+}
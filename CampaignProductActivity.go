@@ -0,0 +1,91 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+)
+
+type CampaignProductActivity struct {
+	Title                   string  `json:"title"`
+	Sku                     string  `json:"sku"`
+	ImageUrl                string  `json:"image_url"`
+	TotalRevenue            float64 `json:"total_revenue"`
+	TotalPurchased          float64 `json:"total_purchased"`
+	CurrencyCode            string  `json:"currency_code"`
+	RecommendationTotal     int     `json:"recommendation_total"`
+	RecommendationPurchased int     `json:"recommendation_purchased"`
+}
+
+type ListCampaignProductActivityConfig struct {
+	CampaignId    string
+	Fields        *[]string
+	ExcludeFields *[]string
+	Count         *int64
+	SortField     *string
+}
+
+type ListCampaignProductActivityResponse struct {
+	Products   []CampaignProductActivity `json:"products"`
+	CampaignId string                    `json:"campaign_id"`
+	TotalItems int                       `json:"total_items"`
+	Links      []Link                    `json:"_links"`
+}
+
+// ListCampaignProductActivity returns the revenue and units sold per product attributed to a campaign
+func (service *Service) ListCampaignProductActivity(cfg *ListCampaignProductActivityConfig) (*[]CampaignProductActivity, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListCampaignProductActivityConfig must not be nil")
+	}
+
+	var products []CampaignProductActivity
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var count = countDefault
+	if cfg.Count != nil {
+		count = *cfg.Count
+	}
+	values.Set("count", fmt.Sprintf("%v", count))
+
+	if cfg.SortField != nil {
+		values.Set("sort_field", *cfg.SortField)
+	}
+
+	for {
+		var response ListCampaignProductActivityResponse
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("reports/%s/ecommerce-product-activity?%s", cfg.CampaignId, values.Encode())),
+			ResponseModel: &response,
+		}
+
+		_, _, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		products = append(products, response.Products...)
+
+		if len(products) >= response.TotalItems {
+			break
+		}
+
+		values.Set("offset", fmt.Sprintf("%v", len(products)))
+	}
+
+	return &products, nil
+}
@@ -198,3 +198,117 @@ func (service *Service) ListCampaignReports(cfg *ListCampaignReportsConfig) (*[]
 
 	return &campaignReports, nil
 }
+
+type GetCampaignReportConfig struct {
+	CampaignId    string
+	Fields        *[]string
+	ExcludeFields *[]string
+}
+
+func (service *Service) GetCampaignReport(cfg *GetCampaignReportConfig) (*CampaignReport, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("GetCampaignReportConfig must not be nil")
+	}
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var campaignReport CampaignReport
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("reports/%s?%s", cfg.CampaignId, values.Encode())),
+		ResponseModel: &campaignReport,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &campaignReport, nil
+}
+
+type ListCampaignSubReportsConfig struct {
+	CampaignId    string
+	Fields        *[]string
+	ExcludeFields *[]string
+}
+
+type ListCampaignSubReportsResponse struct {
+	CampaignReports  []CampaignReport `json:"reports"`
+	ParentCampaignId string           `json:"parent_campaign_id"`
+	TotalItems       int              `json:"total_items"`
+	Links            []Link           `json:"_links"`
+}
+
+// ListCampaignSubReports returns the reports of the child campaigns of a parent campaign,
+// which for a multivariate campaign are the results per combination and for an RSS campaign the sent campaigns
+func (service *Service) ListCampaignSubReports(cfg *ListCampaignSubReportsConfig) (*[]CampaignReport, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListCampaignSubReportsConfig must not be nil")
+	}
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var response ListCampaignSubReportsResponse
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("reports/%s/sub-reports?%s", cfg.CampaignId, values.Encode())),
+		ResponseModel: &response,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &response.CampaignReports, nil
+}
+
+type ListRssChildReportsConfig struct {
+	CampaignId    string
+	Fields        *[]string
+	ExcludeFields *[]string
+}
+
+// ListRssChildReports returns the reports of the campaigns sent by an RSS campaign
+func (service *Service) ListRssChildReports(cfg *ListRssChildReportsConfig) (*[]CampaignReport, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListRssChildReportsConfig must not be nil")
+	}
+
+	campaign, e := service.GetCampaign(&GetCampaignConfig{
+		CampaignId: cfg.CampaignId,
+		Fields:     &[]string{"id", "type"},
+	})
+	if e != nil {
+		return nil, e
+	}
+
+	if campaign.Type != string(CampaignTypeRss) {
+		return nil, errortools.ErrorMessage(fmt.Sprintf("Campaign '%s' is of type '%s', not '%s'", cfg.CampaignId, campaign.Type, CampaignTypeRss))
+	}
+
+	return service.ListCampaignSubReports(&ListCampaignSubReportsConfig{
+		CampaignId:    cfg.CampaignId,
+		Fields:        cfg.Fields,
+		ExcludeFields: cfg.ExcludeFields,
+	})
+}
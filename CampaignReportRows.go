@@ -0,0 +1,171 @@
+package mailchimp
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+)
+
+// CampaignReportTimeseriesRow is one hour of a campaign's timeseries
+type CampaignReportTimeseriesRow struct {
+	CampaignId       string    `json:"campaign_id"`
+	Timestamp        time.Time `json:"timestamp"`
+	EmailsSent       int       `json:"emails_sent"`
+	UniqueOpens      int       `json:"unique_opens"`
+	RecipientsClicks int       `json:"recipients_clicks"`
+}
+
+// CampaignReportTimewarpRow holds a campaign's activity for one GMT offset
+type CampaignReportTimewarpRow struct {
+	CampaignId   string     `json:"campaign_id"`
+	GmtOffset    int        `json:"gmt_offset"`
+	Opens        int        `json:"opens"`
+	LastOpen     *time.Time `json:"last_open"`
+	UniqueOpens  int        `json:"unique_opens"`
+	Clicks       int        `json:"clicks"`
+	LastClick    *time.Time `json:"last_click"`
+	UniqueClicks int        `json:"unique_clicks"`
+	Bounces      int        `json:"bounces"`
+}
+
+// FlattenCampaignReportTimeseries converts the timeseries of one or more campaign reports into hourly rows,
+// items without a timestamp are skipped
+func FlattenCampaignReportTimeseries(campaignReports ...CampaignReport) []CampaignReportTimeseriesRow {
+	var rows []CampaignReportTimeseriesRow
+
+	for _, campaignReport := range campaignReports {
+		for _, item := range campaignReport.Timeseries {
+			timestamp := item.Timestamp.ValuePtr()
+			if timestamp == nil {
+				continue
+			}
+
+			rows = append(rows, CampaignReportTimeseriesRow{
+				CampaignId:       campaignReport.Id,
+				Timestamp:        *timestamp,
+				EmailsSent:       item.EmailsSent,
+				UniqueOpens:      item.UniqueOpens,
+				RecipientsClicks: item.RecipientsClicks,
+			})
+		}
+	}
+
+	return rows
+}
+
+// FlattenCampaignReportTimewarp converts the timewarp data of one or more campaign reports into rows per GMT offset
+func FlattenCampaignReportTimewarp(campaignReports ...CampaignReport) []CampaignReportTimewarpRow {
+	var rows []CampaignReportTimewarpRow
+
+	for _, campaignReport := range campaignReports {
+		for _, item := range campaignReport.Timewarp {
+			rows = append(rows, CampaignReportTimewarpRow{
+				CampaignId:   campaignReport.Id,
+				GmtOffset:    item.GmtOffset,
+				Opens:        item.Opens,
+				LastOpen:     item.LastOpen.ValuePtr(),
+				UniqueOpens:  item.UniqueOpens,
+				Clicks:       item.Clicks,
+				LastClick:    item.LastClick.ValuePtr(),
+				UniqueClicks: item.UniqueClicks,
+				Bounces:      item.Bounces,
+			})
+		}
+	}
+
+	return rows
+}
+
+func WriteCampaignReportTimeseriesCsv(w io.Writer, rows []CampaignReportTimeseriesRow) *errortools.Error {
+	var records = [][]string{
+		{"campaign_id", "timestamp", "emails_sent", "unique_opens", "recipients_clicks"},
+	}
+
+	for _, row := range rows {
+		records = append(records, []string{
+			row.CampaignId,
+			row.Timestamp.Format(time.RFC3339),
+			fmt.Sprintf("%v", row.EmailsSent),
+			fmt.Sprintf("%v", row.UniqueOpens),
+			fmt.Sprintf("%v", row.RecipientsClicks),
+		})
+	}
+
+	return writeCsv(w, records)
+}
+
+func WriteCampaignReportTimewarpCsv(w io.Writer, rows []CampaignReportTimewarpRow) *errortools.Error {
+	var records = [][]string{
+		{"campaign_id", "gmt_offset", "opens", "last_open", "unique_opens", "clicks", "last_click", "unique_clicks", "bounces"},
+	}
+
+	for _, row := range rows {
+		records = append(records, []string{
+			row.CampaignId,
+			fmt.Sprintf("%v", row.GmtOffset),
+			fmt.Sprintf("%v", row.Opens),
+			formatTimePtr(row.LastOpen),
+			fmt.Sprintf("%v", row.UniqueOpens),
+			fmt.Sprintf("%v", row.Clicks),
+			formatTimePtr(row.LastClick),
+			fmt.Sprintf("%v", row.UniqueClicks),
+			fmt.Sprintf("%v", row.Bounces),
+		})
+	}
+
+	return writeCsv(w, records)
+}
+
+func WriteCampaignReportTimeseriesJsonl(w io.Writer, rows []CampaignReportTimeseriesRow) *errortools.Error {
+	var values []interface{}
+	for _, row := range rows {
+		values = append(values, row)
+	}
+
+	return writeJsonl(w, values)
+}
+
+func WriteCampaignReportTimewarpJsonl(w io.Writer, rows []CampaignReportTimewarpRow) *errortools.Error {
+	var values []interface{}
+	for _, row := range rows {
+		values = append(values, row)
+	}
+
+	return writeJsonl(w, values)
+}
+
+func formatTimePtr(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+func writeCsv(w io.Writer, records [][]string) *errortools.Error {
+	writer := csv.NewWriter(w)
+
+	err := writer.WriteAll(records)
+	if err != nil {
+		return errortools.ErrorMessage(err)
+	}
+
+	return nil
+}
+
+func writeJsonl(w io.Writer, values []interface{}) *errortools.Error {
+	encoder := json.NewEncoder(w)
+
+	for _, value := range values {
+		err := encoder.Encode(value)
+		if err != nil {
+			return errortools.ErrorMessage(err)
+		}
+	}
+
+	return nil
+}
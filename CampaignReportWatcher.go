@@ -0,0 +1,150 @@
+package mailchimp
+
+import (
+	"fmt"
+	"time"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+)
+
+type WatchCampaignReportConfig struct {
+	CampaignId string
+	Interval   time.Duration
+	Days       int
+	Stop       <-chan struct{}
+}
+
+// CampaignReportDelta holds the change in counters between two consecutive fetches of a campaign report.
+// If fetching failed, Error is set and Report holds the last successfully fetched report.
+type CampaignReportDelta struct {
+	Timestamp    time.Time
+	Report       CampaignReport
+	Opens        int
+	UniqueOpens  int
+	Clicks       int
+	UniqueClicks int
+	HardBounces  int
+	SoftBounces  int
+	Unsubscribed int
+	AbuseReports int
+	Error        *errortools.Error
+}
+
+// IsZero returns true if none of the counters changed
+func (delta *CampaignReportDelta) IsZero() bool {
+	return delta.Opens == 0 &&
+		delta.UniqueOpens == 0 &&
+		delta.Clicks == 0 &&
+		delta.UniqueClicks == 0 &&
+		delta.HardBounces == 0 &&
+		delta.SoftBounces == 0 &&
+		delta.Unsubscribed == 0 &&
+		delta.AbuseReports == 0
+}
+
+func newCampaignReportDelta(previous *CampaignReport, current *CampaignReport) CampaignReportDelta {
+	if previous == nil {
+		previous = &CampaignReport{}
+	}
+
+	return CampaignReportDelta{
+		Timestamp:    time.Now(),
+		Report:       *current,
+		Opens:        current.Opens.OpensTotal - previous.Opens.OpensTotal,
+		UniqueOpens:  current.Opens.UniqueOpens - previous.Opens.UniqueOpens,
+		Clicks:       current.Clicks.ClicksTotal - previous.Clicks.ClicksTotal,
+		UniqueClicks: current.Clicks.UniqueClicks - previous.Clicks.UniqueClicks,
+		HardBounces:  current.Bounces.HardBounces - previous.Bounces.HardBounces,
+		SoftBounces:  current.Bounces.SoftBounces - previous.Bounces.SoftBounces,
+		Unsubscribed: current.Unsubscribed - previous.Unsubscribed,
+		AbuseReports: current.AbuseReports - previous.AbuseReports,
+	}
+}
+
+// WatchCampaignReport fetches a campaign report every Interval until Days days after its SendTime, or until Stop is closed.
+// The first delta holds the full counters of the initial report, after that a delta is only sent when a counter changed.
+// Stop is required, the returned channel is closed when watching ends.
+// Since the Service is not safe for concurrent use, watch using a Service that is not used elsewhere.
+func (service *Service) WatchCampaignReport(cfg *WatchCampaignReportConfig) (<-chan CampaignReportDelta, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("WatchCampaignReportConfig must not be nil")
+	}
+
+	if cfg.Stop == nil {
+		return nil, errortools.ErrorMessage("Stop not provided")
+	}
+
+	if cfg.Interval <= 0 {
+		return nil, errortools.ErrorMessage("Interval must be positive")
+	}
+
+	if cfg.Days <= 0 {
+		return nil, errortools.ErrorMessage("Days must be positive")
+	}
+
+	report, e := service.GetCampaignReport(&GetCampaignReportConfig{
+		CampaignId: cfg.CampaignId,
+	})
+	if e != nil {
+		return nil, e
+	}
+
+	if report.SendTime.ValuePtr() == nil {
+		return nil, errortools.ErrorMessage(fmt.Sprintf("Campaign '%s' has not been sent", cfg.CampaignId))
+	}
+
+	until := report.SendTime.Value().AddDate(0, 0, cfg.Days)
+
+	deltas := make(chan CampaignReportDelta)
+
+	go func() {
+		defer close(deltas)
+
+		send := func(delta CampaignReportDelta) bool {
+			select {
+			case deltas <- delta:
+				return true
+			case <-cfg.Stop:
+				return false
+			}
+		}
+
+		if !send(newCampaignReportDelta(nil, report)) {
+			return
+		}
+
+		ticker := time.NewTicker(cfg.Interval)
+		defer ticker.Stop()
+
+		for time.Now().Before(until) {
+			select {
+			case <-cfg.Stop:
+				return
+			case <-ticker.C:
+			}
+
+			current, e := service.GetCampaignReport(&GetCampaignReportConfig{
+				CampaignId: cfg.CampaignId,
+			})
+			if e != nil {
+				if !send(CampaignReportDelta{Timestamp: time.Now(), Report: *report, Error: e}) {
+					return
+				}
+				continue
+			}
+
+			delta := newCampaignReportDelta(report, current)
+			report = current
+
+			if delta.IsZero() {
+				continue
+			}
+
+			if !send(delta) {
+				return
+			}
+		}
+	}()
+
+	return deltas, nil
+}
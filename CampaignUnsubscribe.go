@@ -0,0 +1,90 @@
+package mailchimp
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	"github.com/leapforce-libraries/go_mailchimp/types"
+)
+
+type CampaignUnsubscribe struct {
+	EmailId      string                     `json:"email_id"`
+	EmailAddress string                     `json:"email_address"`
+	MergeFields  map[string]json.RawMessage `json:"merge_fields"`
+	Vip          bool                       `json:"vip"`
+	Timestamp    *types.DateTimeString      `json:"timestamp"`
+	Reason       string                     `json:"reason"`
+	CampaignId   string                     `json:"campaign_id"`
+	ListId       string                     `json:"list_id"`
+	ListIsActive bool                       `json:"list_is_active"`
+	Links        []Link                     `json:"_links"`
+}
+
+type ListCampaignUnsubscribesConfig struct {
+	CampaignId    string
+	Fields        *[]string
+	ExcludeFields *[]string
+	Count         *int64
+}
+
+type ListCampaignUnsubscribesResponse struct {
+	Unsubscribes []CampaignUnsubscribe `json:"unsubscribes"`
+	CampaignId   string                `json:"campaign_id"`
+	TotalItems   int                   `json:"total_items"`
+	Links        []Link                `json:"_links"`
+}
+
+// ListCampaignUnsubscribes returns the members that unsubscribed from a campaign, with the reason they gave
+func (service *Service) ListCampaignUnsubscribes(cfg *ListCampaignUnsubscribesConfig) (*[]CampaignUnsubscribe, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListCampaignUnsubscribesConfig must not be nil")
+	}
+
+	var unsubscribes []CampaignUnsubscribe
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var count = countDefault
+	if cfg.Count != nil {
+		count = *cfg.Count
+	}
+	values.Set("count", fmt.Sprintf("%v", count))
+
+	for {
+		var response ListCampaignUnsubscribesResponse
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("reports/%s/unsubscribed?%s", cfg.CampaignId, values.Encode())),
+			ResponseModel: &response,
+		}
+
+		_, _, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		unsubscribes = append(unsubscribes, response.Unsubscribes...)
+
+		if len(unsubscribes) >= response.TotalItems {
+			break
+		}
+
+		values.Set("offset", fmt.Sprintf("%v", len(unsubscribes)))
+	}
+
+	return &unsubscribes, nil
+}
@@ -0,0 +1,256 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+)
+
+type Interest struct {
+	CategoryId      string `json:"category_id"`
+	ListId          string `json:"list_id"`
+	Id              string `json:"id"`
+	Name            string `json:"name"`
+	SubscriberCount string `json:"subscriber_count"`
+	DisplayOrder    int    `json:"display_order"`
+	Links           []Link `json:"_links"`
+}
+
+type ListInterestsConfig struct {
+	ListId             string
+	InterestCategoryId string
+	Fields             *[]string
+	ExcludeFields      *[]string
+	Count              *int64
+}
+
+type ListInterestsResponse struct {
+	Interests  []Interest `json:"interests"`
+	ListId     string     `json:"list_id"`
+	CategoryId string     `json:"category_id"`
+	TotalItems int        `json:"total_items"`
+	Links      []Link     `json:"_links"`
+}
+
+func (service *Service) ListInterests(cfg *ListInterestsConfig) (*[]Interest, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListInterestsConfig must not be nil")
+	}
+
+	var interests []Interest
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var count = countDefault
+	if cfg.Count != nil {
+		count = *cfg.Count
+	}
+	values.Set("count", fmt.Sprintf("%v", count))
+
+	for {
+		var response ListInterestsResponse
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("lists/%s/interest-categories/%s/interests?%s", cfg.ListId, cfg.InterestCategoryId, values.Encode())),
+			ResponseModel: &response,
+		}
+
+		_, _, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		interests = append(interests, response.Interests...)
+
+		if len(interests) >= response.TotalItems {
+			break
+		}
+
+		values.Set("offset", fmt.Sprintf("%v", len(interests)))
+	}
+
+	return &interests, nil
+}
+
+type GetInterestConfig struct {
+	ListId             string
+	InterestCategoryId string
+	InterestId         string
+}
+
+func (service *Service) GetInterest(cfg *GetInterestConfig) (*Interest, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("GetInterestConfig must not be nil")
+	}
+
+	var interest Interest
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("lists/%s/interest-categories/%s/interests/%s", cfg.ListId, cfg.InterestCategoryId, cfg.InterestId)),
+		ResponseModel: &interest,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &interest, nil
+}
+
+type CreateInterestConfig struct {
+	ListId             string `json:"-"`
+	InterestCategoryId string `json:"-"`
+	Name               string `json:"name"`
+	DisplayOrder       *int   `json:"display_order,omitempty"`
+}
+
+func (service *Service) CreateInterest(cfg *CreateInterestConfig) (*Interest, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("CreateInterestConfig must not be nil")
+	}
+
+	var interest Interest
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url(fmt.Sprintf("lists/%s/interest-categories/%s/interests", cfg.ListId, cfg.InterestCategoryId)),
+		BodyModel:     cfg,
+		ResponseModel: &interest,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &interest, nil
+}
+
+type UpdateInterestConfig struct {
+	ListId             string `json:"-"`
+	InterestCategoryId string `json:"-"`
+	InterestId         string `json:"-"`
+	Name               string `json:"name"`
+	DisplayOrder       *int   `json:"display_order,omitempty"`
+}
+
+func (service *Service) UpdateInterest(cfg *UpdateInterestConfig) (*Interest, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("UpdateInterestConfig must not be nil")
+	}
+
+	var interest Interest
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPatch,
+		Url:           service.url(fmt.Sprintf("lists/%s/interest-categories/%s/interests/%s", cfg.ListId, cfg.InterestCategoryId, cfg.InterestId)),
+		BodyModel:     cfg,
+		ResponseModel: &interest,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &interest, nil
+}
+
+type DeleteInterestConfig struct {
+	ListId             string
+	InterestCategoryId string
+	InterestId         string
+}
+
+func (service *Service) DeleteInterest(cfg *DeleteInterestConfig) *errortools.Error {
+	if cfg == nil {
+		return errortools.ErrorMessage("DeleteInterestConfig must not be nil")
+	}
+
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodDelete,
+		Url:    service.url(fmt.Sprintf("lists/%s/interest-categories/%s/interests/%s", cfg.ListId, cfg.InterestCategoryId, cfg.InterestId)),
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return e
+	}
+
+	return nil
+}
+
+type GetInterestNamesConfig struct {
+	ListId string
+}
+
+// GetInterestNames returns a map of all interest ids of a list to their "Category: Interest" name
+func (service *Service) GetInterestNames(cfg *GetInterestNamesConfig) (*map[string]string, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("GetInterestNamesConfig must not be nil")
+	}
+
+	interestCategories, e := service.ListInterestCategories(&ListInterestCategoriesConfig{
+		ListId: cfg.ListId,
+	})
+	if e != nil {
+		return nil, e
+	}
+
+	var interestNames = make(map[string]string)
+
+	for _, interestCategory := range *interestCategories {
+		interests, e := service.ListInterests(&ListInterestsConfig{
+			ListId:             cfg.ListId,
+			InterestCategoryId: interestCategory.Id,
+		})
+		if e != nil {
+			return nil, e
+		}
+
+		for _, interest := range *interests {
+			interestNames[interest.Id] = fmt.Sprintf("%s: %s", interestCategory.Title, interest.Name)
+		}
+	}
+
+	return &interestNames, nil
+}
+
+// InterestNames returns the sorted names of the interests the member is subscribed to, using a map as returned by GetInterestNames.
+// Interest ids missing from the map are returned as is.
+func (listMember *ListMember) InterestNames(interestNames map[string]string) []string {
+	var names []string
+
+	for interestId, subscribed := range listMember.Interests {
+		if !subscribed {
+			continue
+		}
+
+		name, ok := interestNames[interestId]
+		if !ok {
+			name = interestId
+		}
+
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
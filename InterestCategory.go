@@ -0,0 +1,205 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+)
+
+type InterestCategory struct {
+	ListId       string               `json:"list_id"`
+	Id           string               `json:"id"`
+	Title        string               `json:"title"`
+	DisplayOrder int                  `json:"display_order"`
+	Type         InterestCategoryType `json:"type"`
+	Links        []Link               `json:"_links"`
+}
+
+type InterestCategoryType string
+
+const (
+	InterestCategoryTypeCheckboxes InterestCategoryType = "checkboxes"
+	InterestCategoryTypeDropdown   InterestCategoryType = "dropdown"
+	InterestCategoryTypeRadio      InterestCategoryType = "radio"
+	InterestCategoryTypeHidden     InterestCategoryType = "hidden"
+)
+
+type ListInterestCategoriesConfig struct {
+	ListId        string
+	Fields        *[]string
+	ExcludeFields *[]string
+	Count         *int64
+	Type          *InterestCategoryType
+}
+
+type ListInterestCategoriesResponse struct {
+	ListId             string             `json:"list_id"`
+	InterestCategories []InterestCategory `json:"categories"`
+	TotalItems         int                `json:"total_items"`
+	Links              []Link             `json:"_links"`
+}
+
+func (service *Service) ListInterestCategories(cfg *ListInterestCategoriesConfig) (*[]InterestCategory, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListInterestCategoriesConfig must not be nil")
+	}
+
+	var interestCategories []InterestCategory
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var count = countDefault
+	if cfg.Count != nil {
+		count = *cfg.Count
+	}
+	values.Set("count", fmt.Sprintf("%v", count))
+
+	if cfg.Type != nil {
+		values.Set("type", string(*cfg.Type))
+	}
+
+	for {
+		var response ListInterestCategoriesResponse
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("lists/%s/interest-categories?%s", cfg.ListId, values.Encode())),
+			ResponseModel: &response,
+		}
+
+		_, _, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		interestCategories = append(interestCategories, response.InterestCategories...)
+
+		if len(interestCategories) >= response.TotalItems {
+			break
+		}
+
+		values.Set("offset", fmt.Sprintf("%v", len(interestCategories)))
+	}
+
+	return &interestCategories, nil
+}
+
+type GetInterestCategoryConfig struct {
+	ListId             string
+	InterestCategoryId string
+}
+
+func (service *Service) GetInterestCategory(cfg *GetInterestCategoryConfig) (*InterestCategory, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("GetInterestCategoryConfig must not be nil")
+	}
+
+	var interestCategory InterestCategory
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("lists/%s/interest-categories/%s", cfg.ListId, cfg.InterestCategoryId)),
+		ResponseModel: &interestCategory,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &interestCategory, nil
+}
+
+type CreateInterestCategoryConfig struct {
+	ListId       string               `json:"-"`
+	Title        string               `json:"title"`
+	Type         InterestCategoryType `json:"type"`
+	DisplayOrder *int                 `json:"display_order,omitempty"`
+}
+
+func (service *Service) CreateInterestCategory(cfg *CreateInterestCategoryConfig) (*InterestCategory, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("CreateInterestCategoryConfig must not be nil")
+	}
+
+	var interestCategory InterestCategory
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url(fmt.Sprintf("lists/%s/interest-categories", cfg.ListId)),
+		BodyModel:     cfg,
+		ResponseModel: &interestCategory,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &interestCategory, nil
+}
+
+type UpdateInterestCategoryConfig struct {
+	ListId             string               `json:"-"`
+	InterestCategoryId string               `json:"-"`
+	Title              string               `json:"title"`
+	Type               InterestCategoryType `json:"type"`
+	DisplayOrder       *int                 `json:"display_order,omitempty"`
+}
+
+func (service *Service) UpdateInterestCategory(cfg *UpdateInterestCategoryConfig) (*InterestCategory, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("UpdateInterestCategoryConfig must not be nil")
+	}
+
+	var interestCategory InterestCategory
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPatch,
+		Url:           service.url(fmt.Sprintf("lists/%s/interest-categories/%s", cfg.ListId, cfg.InterestCategoryId)),
+		BodyModel:     cfg,
+		ResponseModel: &interestCategory,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &interestCategory, nil
+}
+
+type DeleteInterestCategoryConfig struct {
+	ListId             string
+	InterestCategoryId string
+}
+
+func (service *Service) DeleteInterestCategory(cfg *DeleteInterestCategoryConfig) *errortools.Error {
+	if cfg == nil {
+		return errortools.ErrorMessage("DeleteInterestCategoryConfig must not be nil")
+	}
+
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodDelete,
+		Url:    service.url(fmt.Sprintf("lists/%s/interest-categories/%s", cfg.ListId, cfg.InterestCategoryId)),
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return e
+	}
+
+	return nil
+}
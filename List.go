@@ -173,3 +173,205 @@ func (service *Service) ListLists(cfg *ListListsConfig) (*[]List, *errortools.Er
 
 	return &lists, nil
 }
+
+type GetListConfig struct {
+	ListId               string
+	Fields               *[]string
+	ExcludeFields        *[]string
+	IncludeTotalContacts *bool
+}
+
+func (service *Service) GetList(cfg *GetListConfig) (*List, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("GetListConfig must not be nil")
+	}
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	if cfg.IncludeTotalContacts != nil {
+		values.Set("include_total_contacts", fmt.Sprintf("%v", *cfg.IncludeTotalContacts))
+	}
+
+	var list List
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("lists/%s?%s", cfg.ListId, values.Encode())),
+		ResponseModel: &list,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &list, nil
+}
+
+type CreateListConfig struct {
+	Name                 string            `json:"name"`
+	Contact              *Contact          `json:"contact"`
+	PermissionReminder   string            `json:"permission_reminder"`
+	UseArchiveBar        *bool             `json:"use_archive_bar,omitempty"`
+	CampaignDefaults     *CampaignDefaults `json:"campaign_defaults"`
+	NotifyOnSubscribe    *string           `json:"notify_on_subscribe,omitempty"`
+	NotifyOnUnsubscribe  *string           `json:"notify_on_unsubscribe,omitempty"`
+	EmailTypeOption      *bool             `json:"email_type_option"`
+	DoubleOptin          *bool             `json:"double_optin,omitempty"`
+	MarketingPermissions *bool             `json:"marketing_permissions,omitempty"`
+}
+
+func (service *Service) CreateList(cfg *CreateListConfig) (*List, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("CreateListConfig must not be nil")
+	}
+
+	e := validateList(cfg.Name, cfg.Contact, cfg.PermissionReminder, cfg.CampaignDefaults, cfg.EmailTypeOption)
+	if e != nil {
+		return nil, e
+	}
+
+	var list List
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url("lists"),
+		BodyModel:     cfg,
+		ResponseModel: &list,
+	}
+
+	_, _, e = service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &list, nil
+}
+
+type UpdateListConfig struct {
+	ListId               string            `json:"-"`
+	Name                 string            `json:"name"`
+	Contact              *Contact          `json:"contact"`
+	PermissionReminder   string            `json:"permission_reminder"`
+	UseArchiveBar        *bool             `json:"use_archive_bar,omitempty"`
+	CampaignDefaults     *CampaignDefaults `json:"campaign_defaults"`
+	NotifyOnSubscribe    *string           `json:"notify_on_subscribe,omitempty"`
+	NotifyOnUnsubscribe  *string           `json:"notify_on_unsubscribe,omitempty"`
+	EmailTypeOption      *bool             `json:"email_type_option"`
+	DoubleOptin          *bool             `json:"double_optin,omitempty"`
+	MarketingPermissions *bool             `json:"marketing_permissions,omitempty"`
+}
+
+func (service *Service) UpdateList(cfg *UpdateListConfig) (*List, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("UpdateListConfig must not be nil")
+	}
+
+	e := validateList(cfg.Name, cfg.Contact, cfg.PermissionReminder, cfg.CampaignDefaults, cfg.EmailTypeOption)
+	if e != nil {
+		return nil, e
+	}
+
+	var list List
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPatch,
+		Url:           service.url(fmt.Sprintf("lists/%s", cfg.ListId)),
+		BodyModel:     cfg,
+		ResponseModel: &list,
+	}
+
+	_, _, e = service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &list, nil
+}
+
+type DeleteListConfig struct {
+	ListId string
+}
+
+func (service *Service) DeleteList(cfg *DeleteListConfig) *errortools.Error {
+	if cfg == nil {
+		return errortools.ErrorMessage("DeleteListConfig must not be nil")
+	}
+
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodDelete,
+		Url:    service.url(fmt.Sprintf("lists/%s", cfg.ListId)),
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return e
+	}
+
+	return nil
+}
+
+// validateList checks the fields Mailchimp requires when creating or updating a list
+func validateList(name string, contact *Contact, permissionReminder string, campaignDefaults *CampaignDefaults, emailTypeOption *bool) *errortools.Error {
+	if name == "" {
+		return errortools.ErrorMessage("Name not provided")
+	}
+
+	if contact == nil {
+		return errortools.ErrorMessage("Contact not provided")
+	}
+
+	var contactFields = []struct {
+		name  string
+		value string
+	}{
+		{"Company", contact.Company},
+		{"Address1", contact.Address1},
+		{"City", contact.City},
+		{"State", contact.State},
+		{"Zip", contact.Zip},
+		{"Country", contact.Country},
+	}
+	for _, field := range contactFields {
+		if field.value == "" {
+			return errortools.ErrorMessage(fmt.Sprintf("Contact.%s not provided", field.name))
+		}
+	}
+
+	if permissionReminder == "" {
+		return errortools.ErrorMessage("PermissionReminder not provided")
+	}
+
+	if campaignDefaults == nil {
+		return errortools.ErrorMessage("CampaignDefaults not provided")
+	}
+
+	var campaignDefaultsFields = []struct {
+		name  string
+		value string
+	}{
+		{"FromName", campaignDefaults.FromName},
+		{"FromEmail", campaignDefaults.FromEmail},
+		{"Subject", campaignDefaults.Subject},
+		{"Language", campaignDefaults.Language},
+	}
+	for _, field := range campaignDefaultsFields {
+		if field.value == "" {
+			return errortools.ErrorMessage(fmt.Sprintf("CampaignDefaults.%s not provided", field.name))
+		}
+	}
+
+	if emailTypeOption == nil {
+		return errortools.ErrorMessage("EmailTypeOption not provided")
+	}
+
+	return nil
+}
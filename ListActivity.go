@@ -0,0 +1,89 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	"github.com/leapforce-libraries/go_mailchimp/types"
+)
+
+type ListActivity struct {
+	Day             types.DateString `json:"day"`
+	EmailsSent      int              `json:"emails_sent"`
+	UniqueOpens     int              `json:"unique_opens"`
+	RecipientClicks int              `json:"recipient_clicks"`
+	HardBounce      int              `json:"hard_bounce"`
+	SoftBounce      int              `json:"soft_bounce"`
+	Subs            int              `json:"subs"`
+	Unsubs          int              `json:"unsubs"`
+	OtherAdds       int              `json:"other_adds"`
+	OtherRemoves    int              `json:"other_removes"`
+}
+
+type ListRecentActivityConfig struct {
+	ListId        string
+	Fields        *[]string
+	ExcludeFields *[]string
+	Count         *int64
+}
+
+type ListRecentActivityResponse struct {
+	Activity   []ListActivity `json:"activity"`
+	ListId     string         `json:"list_id"`
+	TotalItems int            `json:"total_items"`
+	Links      []Link         `json:"_links"`
+}
+
+// ListRecentActivity returns the daily activity of a list over the last 180 days
+func (service *Service) ListRecentActivity(cfg *ListRecentActivityConfig) (*[]ListActivity, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListRecentActivityConfig must not be nil")
+	}
+
+	var activity []ListActivity
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var count = countDefault
+	if cfg.Count != nil {
+		count = *cfg.Count
+	}
+	values.Set("count", fmt.Sprintf("%v", count))
+
+	for {
+		var response ListRecentActivityResponse
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("lists/%s/activity?%s", cfg.ListId, values.Encode())),
+			ResponseModel: &response,
+		}
+
+		_, _, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		activity = append(activity, response.Activity...)
+
+		if len(activity) >= response.TotalItems {
+			break
+		}
+
+		values.Set("offset", fmt.Sprintf("%v", len(activity)))
+	}
+
+	return &activity, nil
+}
@@ -0,0 +1,61 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+)
+
+type ListClient struct {
+	Client  string `json:"client"`
+	Members int    `json:"members"`
+}
+
+type ListTopClientsConfig struct {
+	ListId        string
+	Fields        *[]string
+	ExcludeFields *[]string
+}
+
+type ListTopClientsResponse struct {
+	Clients    []ListClient `json:"clients"`
+	ListId     string       `json:"list_id"`
+	TotalItems int          `json:"total_items"`
+	Links      []Link       `json:"_links"`
+}
+
+// ListTopClients returns the email clients used most by the members of a list
+func (service *Service) ListTopClients(cfg *ListTopClientsConfig) (*[]ListClient, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListTopClientsConfig must not be nil")
+	}
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var response ListTopClientsResponse
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("lists/%s/clients?%s", cfg.ListId, values.Encode())),
+		ResponseModel: &response,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &response.Clients, nil
+}
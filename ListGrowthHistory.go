@@ -0,0 +1,101 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+)
+
+type ListGrowthHistory struct {
+	ListId        string `json:"list_id"`
+	Month         string `json:"month"`
+	Existing      int    `json:"existing"`
+	Imports       int    `json:"imports"`
+	Optins        int    `json:"optins"`
+	Subscribed    int    `json:"subscribed"`
+	Unsubscribed  int    `json:"unsubscribed"`
+	Reconfirm     int    `json:"reconfirm"`
+	Cleaned       int    `json:"cleaned"`
+	Pending       int    `json:"pending"`
+	Deleted       int    `json:"deleted"`
+	Transactional int    `json:"transactional"`
+	Links         []Link `json:"_links"`
+}
+
+type ListGrowthHistoryConfig struct {
+	ListId        string
+	Fields        *[]string
+	ExcludeFields *[]string
+	Count         *int64
+	SortField     *string
+	SortDir       *string
+}
+
+type ListGrowthHistoryResponse struct {
+	ListId     string              `json:"list_id"`
+	History    []ListGrowthHistory `json:"history"`
+	TotalItems int                 `json:"total_items"`
+	Links      []Link              `json:"_links"`
+}
+
+// ListGrowthHistory returns the monthly growth history of a list, Month is formatted as yyyy-mm
+func (service *Service) ListGrowthHistory(cfg *ListGrowthHistoryConfig) (*[]ListGrowthHistory, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListGrowthHistoryConfig must not be nil")
+	}
+
+	var history []ListGrowthHistory
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var count = countDefault
+	if cfg.Count != nil {
+		count = *cfg.Count
+	}
+	values.Set("count", fmt.Sprintf("%v", count))
+
+	if cfg.SortField != nil {
+		values.Set("sort_field", *cfg.SortField)
+	}
+
+	if cfg.SortDir != nil {
+		values.Set("sort_dir", *cfg.SortDir)
+	}
+
+	for {
+		var response ListGrowthHistoryResponse
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("lists/%s/growth-history?%s", cfg.ListId, values.Encode())),
+			ResponseModel: &response,
+		}
+
+		_, _, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		history = append(history, response.History...)
+
+		if len(history) >= response.TotalItems {
+			break
+		}
+
+		values.Set("offset", fmt.Sprintf("%v", len(history)))
+	}
+
+	return &history, nil
+}
@@ -0,0 +1,81 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+)
+
+type ListLocation struct {
+	Country string  `json:"country"`
+	Cc      string  `json:"cc"`
+	Percent float64 `json:"percent"`
+	Total   int     `json:"total"`
+}
+
+type ListTopLocationsConfig struct {
+	ListId        string
+	Fields        *[]string
+	ExcludeFields *[]string
+	Count         *int64
+}
+
+type ListTopLocationsResponse struct {
+	Locations  []ListLocation `json:"locations"`
+	ListId     string         `json:"list_id"`
+	TotalItems int            `json:"total_items"`
+	Links      []Link         `json:"_links"`
+}
+
+func (service *Service) ListTopLocations(cfg *ListTopLocationsConfig) (*[]ListLocation, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListTopLocationsConfig must not be nil")
+	}
+
+	var locations []ListLocation
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var count = countDefault
+	if cfg.Count != nil {
+		count = *cfg.Count
+	}
+	values.Set("count", fmt.Sprintf("%v", count))
+
+	for {
+		var response ListTopLocationsResponse
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("lists/%s/locations?%s", cfg.ListId, values.Encode())),
+			ResponseModel: &response,
+		}
+
+		_, _, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		locations = append(locations, response.Locations...)
+
+		if len(locations) >= response.TotalItems {
+			break
+		}
+
+		values.Set("offset", fmt.Sprintf("%v", len(locations)))
+	}
+
+	return &locations, nil
+}
@@ -1,6 +1,8 @@
 package mailchimp
 
 import (
+	"crypto/md5"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -36,6 +38,7 @@ type ListMember struct {
 	Vip                         bool                       `json:"vip"`
 	EmailClient                 string                     `json:"email_client"`
 	Location                    Location                   `json:"location"`
+	MarketingPermissions        []MarketingPermission      `json:"marketing_permissions"`
 	Source                      string                     `json:"source"`
 	TagsCount                   int                        `json:"tags_count"`
 	Tags                        []Tag                      `json:"tags"`
@@ -43,6 +46,12 @@ type ListMember struct {
 	Links                       []Link                     `json:"_links"`
 }
 
+// SubscriberHash returns the MD5 hash of the lowercase email address, which Mailchimp uses to identify a list member
+func SubscriberHash(emailAddress string) string {
+	hash := md5.Sum([]byte(strings.ToLower(emailAddress)))
+	return hex.EncodeToString(hash[:])
+}
+
 type ListMemberStats struct {
 	AvgOpenRate   float64 `json:"avg_open_rate"`
 	AvgClickRate  float64 `json:"avg_click_rate"`
@@ -199,3 +208,181 @@ func (service *Service) ListListMembers(cfg *ListListMembersConfig) (*[]ListMemb
 
 	return &listMembers, nil
 }
+
+type SearchMembersConfig struct {
+	Query         string
+	ListId        *string
+	Fields        *[]string
+	ExcludeFields *[]string
+}
+
+type SearchMembersResponse struct {
+	ExactMatches SearchMembersMatches `json:"exact_matches"`
+	FullSearch   SearchMembersMatches `json:"full_search"`
+	Links        []Link               `json:"_links"`
+}
+
+type SearchMembersMatches struct {
+	Members    []ListMember `json:"members"`
+	TotalItems int          `json:"total_items"`
+}
+
+// SearchMembers searches members by email address or name, across all lists unless ListId is provided
+func (service *Service) SearchMembers(cfg *SearchMembersConfig) (*SearchMembersResponse, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("SearchMembersConfig must not be nil")
+	}
+
+	if cfg.Query == "" {
+		return nil, errortools.ErrorMessage("Query not provided")
+	}
+
+	var values = url.Values{}
+
+	values.Set("query", cfg.Query)
+
+	if cfg.ListId != nil {
+		values.Set("list_id", *cfg.ListId)
+	}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var response SearchMembersResponse
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("search-members?%s", values.Encode())),
+		ResponseModel: &response,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &response, nil
+}
+
+type CreateListMemberConfig struct {
+	ListId                     string                  `json:"-"`
+	SkipMergeValidation        *bool                   `json:"-"`
+	MarketingPermissionsByText *map[string]bool        `json:"-"`
+	EmailAddress               string                  `json:"email_address"`
+	Status                     string                  `json:"status"`
+	EmailType                  *string                 `json:"email_type,omitempty"`
+	MergeFields                *map[string]interface{} `json:"merge_fields,omitempty"`
+	Interests                  *map[string]bool        `json:"interests,omitempty"`
+	Language                   *string                 `json:"language,omitempty"`
+	Vip                        *bool                   `json:"vip,omitempty"`
+	MarketingPermissions       *[]MarketingPermission  `json:"marketing_permissions,omitempty"`
+	IpSignup                   *string                 `json:"ip_signup,omitempty"`
+	TimestampSignup            *types.DateTimeString   `json:"timestamp_signup,omitempty"`
+	IpOpt                      *string                 `json:"ip_opt,omitempty"`
+	TimestampOpt               *types.DateTimeString   `json:"timestamp_opt,omitempty"`
+	Tags                       *[]string               `json:"tags,omitempty"`
+}
+
+// CreateListMember adds a member to a list.
+// Marketing permissions can be set by id through MarketingPermissions or by their text through MarketingPermissionsByText.
+func (service *Service) CreateListMember(cfg *CreateListMemberConfig) (*ListMember, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("CreateListMemberConfig must not be nil")
+	}
+
+	var body = *cfg
+
+	if cfg.MarketingPermissionsByText != nil {
+		marketingPermissions, e := service.resolveMarketingPermissions(cfg.ListId, cfg.MarketingPermissions, *cfg.MarketingPermissionsByText)
+		if e != nil {
+			return nil, e
+		}
+		body.MarketingPermissions = marketingPermissions
+	}
+
+	var values = url.Values{}
+
+	if cfg.SkipMergeValidation != nil {
+		values.Set("skip_merge_validation", fmt.Sprintf("%v", *cfg.SkipMergeValidation))
+	}
+
+	var listMember ListMember
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url(fmt.Sprintf("lists/%s/members?%s", cfg.ListId, values.Encode())),
+		BodyModel:     body,
+		ResponseModel: &listMember,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &listMember, nil
+}
+
+type UpdateListMemberConfig struct {
+	ListId                     string                  `json:"-"`
+	EmailAddress               string                  `json:"-"`
+	SkipMergeValidation        *bool                   `json:"-"`
+	MarketingPermissionsByText *map[string]bool        `json:"-"`
+	NewEmailAddress            *string                 `json:"email_address,omitempty"`
+	Status                     *string                 `json:"status,omitempty"`
+	EmailType                  *string                 `json:"email_type,omitempty"`
+	MergeFields                *map[string]interface{} `json:"merge_fields,omitempty"`
+	Interests                  *map[string]bool        `json:"interests,omitempty"`
+	Language                   *string                 `json:"language,omitempty"`
+	Vip                        *bool                   `json:"vip,omitempty"`
+	MarketingPermissions       *[]MarketingPermission  `json:"marketing_permissions,omitempty"`
+	IpSignup                   *string                 `json:"ip_signup,omitempty"`
+	TimestampSignup            *types.DateTimeString   `json:"timestamp_signup,omitempty"`
+	IpOpt                      *string                 `json:"ip_opt,omitempty"`
+	TimestampOpt               *types.DateTimeString   `json:"timestamp_opt,omitempty"`
+}
+
+// UpdateListMember updates the list member identified by EmailAddress.
+// Marketing permissions can be set by id through MarketingPermissions or by their text through MarketingPermissionsByText.
+func (service *Service) UpdateListMember(cfg *UpdateListMemberConfig) (*ListMember, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("UpdateListMemberConfig must not be nil")
+	}
+
+	var body = *cfg
+
+	if cfg.MarketingPermissionsByText != nil {
+		marketingPermissions, e := service.resolveMarketingPermissions(cfg.ListId, cfg.MarketingPermissions, *cfg.MarketingPermissionsByText)
+		if e != nil {
+			return nil, e
+		}
+		body.MarketingPermissions = marketingPermissions
+	}
+
+	var values = url.Values{}
+
+	if cfg.SkipMergeValidation != nil {
+		values.Set("skip_merge_validation", fmt.Sprintf("%v", *cfg.SkipMergeValidation))
+	}
+
+	var listMember ListMember
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPatch,
+		Url:           service.url(fmt.Sprintf("lists/%s/members/%s?%s", cfg.ListId, SubscriberHash(cfg.EmailAddress), values.Encode())),
+		BodyModel:     body,
+		ResponseModel: &listMember,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &listMember, nil
+}
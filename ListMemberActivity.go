@@ -0,0 +1,96 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	"github.com/leapforce-libraries/go_mailchimp/types"
+)
+
+type ListMemberActivity struct {
+	ActivityType          string                `json:"activity_type"`
+	CreatedAtTimestamp    *types.DateTimeString `json:"created_at_timestamp"`
+	CampaignId            string                `json:"campaign_id"`
+	CampaignTitle         string                `json:"campaign_title"`
+	LinkClicked           string                `json:"link_clicked"`
+	BounceType            string                `json:"bounce_type"`
+	BounceHasOpenActivity bool                  `json:"bounce_has_open_activity"`
+	UnsubscribeReason     string                `json:"unsubscribe_reason"`
+	EventName             string                `json:"event_name"`
+	EventProperties       map[string]string     `json:"event_properties"`
+	Links                 []Link                `json:"_links"`
+}
+
+type ListMemberActivityFeedConfig struct {
+	ListId          string
+	EmailAddress    string
+	Fields          *[]string
+	ExcludeFields   *[]string
+	Count           *int64
+	ActivityFilters *[]string
+}
+
+type ListMemberActivityFeedResponse struct {
+	EmailId    string               `json:"email_id"`
+	ListId     string               `json:"list_id"`
+	Activity   []ListMemberActivity `json:"activity"`
+	TotalItems int                  `json:"total_items"`
+	Links      []Link               `json:"_links"`
+}
+
+func (service *Service) ListMemberActivityFeed(cfg *ListMemberActivityFeedConfig) (*[]ListMemberActivity, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListMemberActivityFeedConfig must not be nil")
+	}
+
+	var activities []ListMemberActivity
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var count = countDefault
+	if cfg.Count != nil {
+		count = *cfg.Count
+	}
+	values.Set("count", fmt.Sprintf("%v", count))
+
+	if cfg.ActivityFilters != nil {
+		values.Set("activity_filters", strings.Join(*cfg.ActivityFilters, ","))
+	}
+
+	for {
+		var response ListMemberActivityFeedResponse
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("lists/%s/members/%s/activity-feed?%s", cfg.ListId, SubscriberHash(cfg.EmailAddress), values.Encode())),
+			ResponseModel: &response,
+		}
+
+		_, _, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		activities = append(activities, response.Activity...)
+
+		if len(activities) >= response.TotalItems {
+			break
+		}
+
+		values.Set("offset", fmt.Sprintf("%v", len(activities)))
+	}
+
+	return &activities, nil
+}
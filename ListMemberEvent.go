@@ -0,0 +1,114 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	"github.com/leapforce-libraries/go_mailchimp/types"
+)
+
+type ListMemberEvent struct {
+	Name       string                `json:"name"`
+	Properties map[string]string     `json:"properties"`
+	OccurredAt *types.DateTimeString `json:"occurred_at"`
+}
+
+type ListMemberEventsConfig struct {
+	ListId        string
+	EmailAddress  string
+	Fields        *[]string
+	ExcludeFields *[]string
+	Count         *int64
+}
+
+type ListMemberEventsResponse struct {
+	Events     []ListMemberEvent `json:"events"`
+	TotalItems int               `json:"total_items"`
+	Links      []Link            `json:"_links"`
+}
+
+func (service *Service) ListMemberEvents(cfg *ListMemberEventsConfig) (*[]ListMemberEvent, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListMemberEventsConfig must not be nil")
+	}
+
+	var events []ListMemberEvent
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var count = countDefault
+	if cfg.Count != nil {
+		count = *cfg.Count
+	}
+	values.Set("count", fmt.Sprintf("%v", count))
+
+	for {
+		var response ListMemberEventsResponse
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("lists/%s/members/%s/events?%s", cfg.ListId, SubscriberHash(cfg.EmailAddress), values.Encode())),
+			ResponseModel: &response,
+		}
+
+		_, _, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		events = append(events, response.Events...)
+
+		if len(events) >= response.TotalItems {
+			break
+		}
+
+		values.Set("offset", fmt.Sprintf("%v", len(events)))
+	}
+
+	return &events, nil
+}
+
+type CreateMemberEventConfig struct {
+	ListId       string                `json:"-"`
+	EmailAddress string                `json:"-"`
+	Name         string                `json:"name"`
+	Properties   *map[string]string    `json:"properties,omitempty"`
+	IsSyncing    *bool                 `json:"is_syncing,omitempty"`
+	OccurredAt   *types.DateTimeString `json:"occurred_at,omitempty"`
+}
+
+// CreateMemberEvent adds a custom event to a list member, which can be used to trigger Customer Journeys
+func (service *Service) CreateMemberEvent(cfg *CreateMemberEventConfig) *errortools.Error {
+	if cfg == nil {
+		return errortools.ErrorMessage("CreateMemberEventConfig must not be nil")
+	}
+
+	if cfg.Name == "" {
+		return errortools.ErrorMessage("Name not provided")
+	}
+
+	requestConfig := go_http.RequestConfig{
+		Method:    http.MethodPost,
+		Url:       service.url(fmt.Sprintf("lists/%s/members/%s/events", cfg.ListId, SubscriberHash(cfg.EmailAddress))),
+		BodyModel: cfg,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return e
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+)
+
+type MarketingPermission struct {
+	Id      string `json:"marketing_permission_id"`
+	Text    string `json:"text,omitempty"`
+	Enabled bool   `json:"enabled"`
+}
+
+type ListMarketingPermissionsConfig struct {
+	ListId string
+}
+
+// ListMarketingPermissions returns the marketing permissions available in a list.
+// Mailchimp has no endpoint for these, so they are read from a member of the list, which means the list must have at least one member.
+func (service *Service) ListMarketingPermissions(cfg *ListMarketingPermissionsConfig) (*[]MarketingPermission, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListMarketingPermissionsConfig must not be nil")
+	}
+
+	var values = url.Values{}
+
+	values.Set("fields", "members.marketing_permissions")
+	values.Set("count", "1")
+
+	var response ListListMembersResponse
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("lists/%s/members?%s", cfg.ListId, values.Encode())),
+		ResponseModel: &response,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	if len(response.ListMembers) == 0 {
+		return nil, errortools.ErrorMessage(fmt.Sprintf("List '%s' has no members to read marketing permissions from", cfg.ListId))
+	}
+
+	var marketingPermissions []MarketingPermission
+
+	for _, marketingPermission := range response.ListMembers[0].MarketingPermissions {
+		marketingPermissions = append(marketingPermissions, MarketingPermission{
+			Id:   marketingPermission.Id,
+			Text: marketingPermission.Text,
+		})
+	}
+
+	return &marketingPermissions, nil
+}
+
+// ResolveMarketingPermissions converts a map of marketing permission text to enabled into marketing permissions with their id.
+// Text is matched case-insensitively against the available marketing permissions, the result is ordered as available.
+func ResolveMarketingPermissions(available []MarketingPermission, enabledByText map[string]bool) (*[]MarketingPermission, *errortools.Error) {
+	var texts []string
+	for text := range enabledByText {
+		texts = append(texts, text)
+	}
+	sort.Strings(texts)
+
+	var enabledById = make(map[string]bool)
+
+	for _, text := range texts {
+		enabled := enabledByText[text]
+		var found = false
+
+		for _, marketingPermission := range available {
+			if !strings.EqualFold(strings.TrimSpace(marketingPermission.Text), strings.TrimSpace(text)) {
+				continue
+			}
+
+			if previous, ok := enabledById[marketingPermission.Id]; ok && previous != enabled {
+				return nil, errortools.ErrorMessage(fmt.Sprintf("Marketing permission '%s' is both enabled and disabled", marketingPermission.Text))
+			}
+			enabledById[marketingPermission.Id] = enabled
+			found = true
+			break
+		}
+
+		if !found {
+			return nil, errortools.ErrorMessage(fmt.Sprintf("Marketing permission '%s' not found", text))
+		}
+	}
+
+	var marketingPermissions []MarketingPermission
+
+	for _, marketingPermission := range available {
+		enabled, ok := enabledById[marketingPermission.Id]
+		if !ok {
+			continue
+		}
+		delete(enabledById, marketingPermission.Id)
+
+		marketingPermissions = append(marketingPermissions, MarketingPermission{
+			Id:      marketingPermission.Id,
+			Enabled: enabled,
+		})
+	}
+
+	return &marketingPermissions, nil
+}
+
+// resolveMarketingPermissions combines marketing permissions by id with the ones resolved by text.
+// Marketing permissions set by id come first in the order given, for duplicate ids the first one wins,
+// so a marketing permission set both by id and by text keeps the value set by id.
+func (service *Service) resolveMarketingPermissions(listId string, marketingPermissions *[]MarketingPermission, enabledByText map[string]bool) (*[]MarketingPermission, *errortools.Error) {
+	available, e := service.ListMarketingPermissions(&ListMarketingPermissionsConfig{
+		ListId: listId,
+	})
+	if e != nil {
+		return nil, e
+	}
+
+	resolved, e := ResolveMarketingPermissions(*available, enabledByText)
+	if e != nil {
+		return nil, e
+	}
+
+	if marketingPermissions == nil {
+		return resolved, nil
+	}
+
+	var combined []MarketingPermission
+	var ids = make(map[string]bool)
+
+	for _, marketingPermission := range append(append([]MarketingPermission{}, *marketingPermissions...), *resolved...) {
+		if ids[marketingPermission.Id] {
+			continue
+		}
+		ids[marketingPermission.Id] = true
+
+		combined = append(combined, marketingPermission)
+	}
+
+	return &combined, nil
+}
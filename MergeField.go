@@ -0,0 +1,452 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+)
+
+type MergeField struct {
+	MergeId      int               `json:"merge_id"`
+	Tag          string            `json:"tag"`
+	Name         string            `json:"name"`
+	Type         MergeFieldType    `json:"type"`
+	Required     bool              `json:"required"`
+	DefaultValue string            `json:"default_value"`
+	Public       bool              `json:"public"`
+	DisplayOrder int               `json:"display_order"`
+	Options      MergeFieldOptions `json:"options"`
+	HelpText     string            `json:"help_text"`
+	ListId       string            `json:"list_id"`
+	Links        []Link            `json:"_links"`
+}
+
+type MergeFieldOptions struct {
+	DefaultCountry int      `json:"default_country,omitempty"`
+	PhoneFormat    string   `json:"phone_format,omitempty"`
+	DateFormat     string   `json:"date_format,omitempty"`
+	Choices        []string `json:"choices,omitempty"`
+	Size           int      `json:"size,omitempty"`
+}
+
+type MergeFieldType string
+
+const (
+	MergeFieldTypeText     MergeFieldType = "text"
+	MergeFieldTypeNumber   MergeFieldType = "number"
+	MergeFieldTypeAddress  MergeFieldType = "address"
+	MergeFieldTypePhone    MergeFieldType = "phone"
+	MergeFieldTypeDate     MergeFieldType = "date"
+	MergeFieldTypeUrl      MergeFieldType = "url"
+	MergeFieldTypeImageUrl MergeFieldType = "imageurl"
+	MergeFieldTypeRadio    MergeFieldType = "radio"
+	MergeFieldTypeDropdown MergeFieldType = "dropdown"
+	MergeFieldTypeBirthday MergeFieldType = "birthday"
+	MergeFieldTypeZip      MergeFieldType = "zip"
+)
+
+type ListMergeFieldsConfig struct {
+	ListId        string
+	Fields        *[]string
+	ExcludeFields *[]string
+	Count         *int64
+	Type          *MergeFieldType
+	Required      *bool
+}
+
+type ListMergeFieldsResponse struct {
+	MergeFields []MergeField `json:"merge_fields"`
+	ListId      string       `json:"list_id"`
+	TotalItems  int          `json:"total_items"`
+	Links       []Link       `json:"_links"`
+}
+
+func (service *Service) ListMergeFields(cfg *ListMergeFieldsConfig) (*[]MergeField, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListMergeFieldsConfig must not be nil")
+	}
+
+	var mergeFields []MergeField
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var count = countDefault
+	if cfg.Count != nil {
+		count = *cfg.Count
+	}
+	values.Set("count", fmt.Sprintf("%v", count))
+
+	if cfg.Type != nil {
+		values.Set("type", string(*cfg.Type))
+	}
+
+	if cfg.Required != nil {
+		values.Set("required", fmt.Sprintf("%v", *cfg.Required))
+	}
+
+	for {
+		var response ListMergeFieldsResponse
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("lists/%s/merge-fields?%s", cfg.ListId, values.Encode())),
+			ResponseModel: &response,
+		}
+
+		_, _, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		mergeFields = append(mergeFields, response.MergeFields...)
+
+		if len(mergeFields) >= response.TotalItems {
+			break
+		}
+
+		values.Set("offset", fmt.Sprintf("%v", len(mergeFields)))
+	}
+
+	return &mergeFields, nil
+}
+
+type GetMergeFieldConfig struct {
+	ListId  string
+	MergeId int
+}
+
+func (service *Service) GetMergeField(cfg *GetMergeFieldConfig) (*MergeField, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("GetMergeFieldConfig must not be nil")
+	}
+
+	var mergeField MergeField
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("lists/%s/merge-fields/%v", cfg.ListId, cfg.MergeId)),
+		ResponseModel: &mergeField,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &mergeField, nil
+}
+
+type CreateMergeFieldConfig struct {
+	ListId       string             `json:"-"`
+	Name         string             `json:"name"`
+	Type         MergeFieldType     `json:"type"`
+	Tag          *string            `json:"tag,omitempty"`
+	Required     *bool              `json:"required,omitempty"`
+	DefaultValue *string            `json:"default_value,omitempty"`
+	Public       *bool              `json:"public,omitempty"`
+	DisplayOrder *int               `json:"display_order,omitempty"`
+	Options      *MergeFieldOptions `json:"options,omitempty"`
+	HelpText     *string            `json:"help_text,omitempty"`
+}
+
+func (service *Service) CreateMergeField(cfg *CreateMergeFieldConfig) (*MergeField, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("CreateMergeFieldConfig must not be nil")
+	}
+
+	var mergeField MergeField
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url(fmt.Sprintf("lists/%s/merge-fields", cfg.ListId)),
+		BodyModel:     cfg,
+		ResponseModel: &mergeField,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &mergeField, nil
+}
+
+type UpdateMergeFieldConfig struct {
+	ListId       string             `json:"-"`
+	MergeId      int                `json:"-"`
+	Name         string             `json:"name"`
+	Tag          *string            `json:"tag,omitempty"`
+	Required     *bool              `json:"required,omitempty"`
+	DefaultValue *string            `json:"default_value,omitempty"`
+	Public       *bool              `json:"public,omitempty"`
+	DisplayOrder *int               `json:"display_order,omitempty"`
+	Options      *MergeFieldOptions `json:"options,omitempty"`
+	HelpText     *string            `json:"help_text,omitempty"`
+}
+
+func (service *Service) UpdateMergeField(cfg *UpdateMergeFieldConfig) (*MergeField, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("UpdateMergeFieldConfig must not be nil")
+	}
+
+	var mergeField MergeField
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPatch,
+		Url:           service.url(fmt.Sprintf("lists/%s/merge-fields/%v", cfg.ListId, cfg.MergeId)),
+		BodyModel:     cfg,
+		ResponseModel: &mergeField,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &mergeField, nil
+}
+
+type DeleteMergeFieldConfig struct {
+	ListId  string
+	MergeId int
+}
+
+func (service *Service) DeleteMergeField(cfg *DeleteMergeFieldConfig) *errortools.Error {
+	if cfg == nil {
+		return errortools.ErrorMessage("DeleteMergeFieldConfig must not be nil")
+	}
+
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodDelete,
+		Url:    service.url(fmt.Sprintf("lists/%s/merge-fields/%v", cfg.ListId, cfg.MergeId)),
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return e
+	}
+
+	return nil
+}
+
+// MergeFieldDefinition describes the desired state of a merge field, matched to existing merge fields by Tag
+type MergeFieldDefinition struct {
+	Tag          string
+	Name         string
+	Type         MergeFieldType
+	Required     bool
+	DefaultValue string
+	Public       bool
+	DisplayOrder *int
+	Options      *MergeFieldOptions
+	HelpText     string
+}
+
+type MergeFieldUpdate struct {
+	Current MergeField
+	Desired MergeFieldDefinition
+}
+
+type MergeFieldsDiff struct {
+	Create []MergeFieldDefinition
+	Update []MergeFieldUpdate
+	Delete []MergeField
+}
+
+// IsEmpty returns true if the diff contains no changes
+func (diff *MergeFieldsDiff) IsEmpty() bool {
+	return len(diff.Create) == 0 && len(diff.Update) == 0 && len(diff.Delete) == 0
+}
+
+// DiffMergeFields computes the changes needed to turn current into desired.
+// Existing merge fields that are not in desired are only marked for deletion if deleteUnlisted is true.
+func DiffMergeFields(current []MergeField, desired []MergeFieldDefinition, deleteUnlisted bool) (*MergeFieldsDiff, *errortools.Error) {
+	var diff MergeFieldsDiff
+
+	var currentByTag = make(map[string]MergeField)
+	for _, mergeField := range current {
+		currentByTag[strings.ToUpper(mergeField.Tag)] = mergeField
+	}
+
+	var desiredTags = make(map[string]bool)
+
+	for _, definition := range desired {
+		tag := strings.ToUpper(definition.Tag)
+		if tag == "" {
+			return nil, errortools.ErrorMessage(fmt.Sprintf("Tag not provided for merge field '%s'", definition.Name))
+		}
+		if desiredTags[tag] {
+			return nil, errortools.ErrorMessage(fmt.Sprintf("Merge field tag '%s' is defined more than once", tag))
+		}
+		desiredTags[tag] = true
+
+		mergeField, ok := currentByTag[tag]
+		if !ok {
+			diff.Create = append(diff.Create, definition)
+			continue
+		}
+
+		if definition.Type != mergeField.Type {
+			return nil, errortools.ErrorMessage(fmt.Sprintf("Type of merge field '%s' cannot be changed from '%s' to '%s'", tag, mergeField.Type, definition.Type))
+		}
+
+		if !mergeFieldMatchesDefinition(&mergeField, &definition) {
+			diff.Update = append(diff.Update, MergeFieldUpdate{
+				Current: mergeField,
+				Desired: definition,
+			})
+		}
+	}
+
+	if deleteUnlisted {
+		for _, mergeField := range current {
+			if !desiredTags[strings.ToUpper(mergeField.Tag)] {
+				diff.Delete = append(diff.Delete, mergeField)
+			}
+		}
+	}
+
+	return &diff, nil
+}
+
+func mergeFieldMatchesDefinition(mergeField *MergeField, definition *MergeFieldDefinition) bool {
+	if mergeField.Name != definition.Name ||
+		mergeField.Required != definition.Required ||
+		mergeField.DefaultValue != definition.DefaultValue ||
+		mergeField.Public != definition.Public ||
+		mergeField.HelpText != definition.HelpText {
+		return false
+	}
+
+	if definition.DisplayOrder != nil && mergeField.DisplayOrder != *definition.DisplayOrder {
+		return false
+	}
+
+	if definition.Options != nil {
+		options := definition.Options
+
+		if options.DefaultCountry != 0 && options.DefaultCountry != mergeField.Options.DefaultCountry {
+			return false
+		}
+		if options.PhoneFormat != "" && options.PhoneFormat != mergeField.Options.PhoneFormat {
+			return false
+		}
+		if options.DateFormat != "" && options.DateFormat != mergeField.Options.DateFormat {
+			return false
+		}
+		if options.Size != 0 && options.Size != mergeField.Options.Size {
+			return false
+		}
+		if options.Choices != nil {
+			if len(options.Choices) != len(mergeField.Options.Choices) {
+				return false
+			}
+			for i := range options.Choices {
+				if options.Choices[i] != mergeField.Options.Choices[i] {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+type ReconcileMergeFieldsConfig struct {
+	ListId         string
+	MergeFields    []MergeFieldDefinition
+	DeleteUnlisted bool
+	DryRun         bool
+}
+
+// ReconcileMergeFields brings the merge fields of a list in line with the desired schema and returns the applied diff.
+// If DryRun is true the diff is computed but not applied.
+// If applying fails, the part of the diff that was applied is returned together with the error.
+func (service *Service) ReconcileMergeFields(cfg *ReconcileMergeFieldsConfig) (*MergeFieldsDiff, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ReconcileMergeFieldsConfig must not be nil")
+	}
+
+	current, e := service.ListMergeFields(&ListMergeFieldsConfig{
+		ListId: cfg.ListId,
+	})
+	if e != nil {
+		return nil, e
+	}
+
+	diff, e := DiffMergeFields(*current, cfg.MergeFields, cfg.DeleteUnlisted)
+	if e != nil {
+		return nil, e
+	}
+
+	if cfg.DryRun {
+		return diff, nil
+	}
+
+	// deletes run last since they remove member data, on error the part of the diff applied so far is returned
+	var applied MergeFieldsDiff
+
+	for _, definition := range diff.Create {
+		tag := strings.ToUpper(definition.Tag)
+		_, e = service.CreateMergeField(&CreateMergeFieldConfig{
+			ListId:       cfg.ListId,
+			Name:         definition.Name,
+			Type:         definition.Type,
+			Tag:          &tag,
+			Required:     &definition.Required,
+			DefaultValue: &definition.DefaultValue,
+			Public:       &definition.Public,
+			DisplayOrder: definition.DisplayOrder,
+			Options:      definition.Options,
+			HelpText:     &definition.HelpText,
+		})
+		if e != nil {
+			return &applied, e
+		}
+		applied.Create = append(applied.Create, definition)
+	}
+
+	for _, update := range diff.Update {
+		desired := update.Desired
+		_, e = service.UpdateMergeField(&UpdateMergeFieldConfig{
+			ListId:       cfg.ListId,
+			MergeId:      update.Current.MergeId,
+			Name:         desired.Name,
+			Required:     &desired.Required,
+			DefaultValue: &desired.DefaultValue,
+			Public:       &desired.Public,
+			DisplayOrder: desired.DisplayOrder,
+			Options:      desired.Options,
+			HelpText:     &desired.HelpText,
+		})
+		if e != nil {
+			return &applied, e
+		}
+		applied.Update = append(applied.Update, update)
+	}
+
+	for _, mergeField := range diff.Delete {
+		e = service.DeleteMergeField(&DeleteMergeFieldConfig{
+			ListId:  cfg.ListId,
+			MergeId: mergeField.MergeId,
+		})
+		if e != nil {
+			return &applied, e
+		}
+		applied.Delete = append(applied.Delete, mergeField)
+	}
+
+	return diff, nil
+}
@@ -0,0 +1,133 @@
+package mailchimp
+
+import (
+	"testing"
+)
+
+func TestDiffMergeFields(t *testing.T) {
+	current := []MergeField{
+		{MergeId: 1, Tag: "FNAME", Name: "First name", Type: MergeFieldTypeText},
+		{MergeId: 2, Tag: "LNAME", Name: "Last name", Type: MergeFieldTypeText},
+		{MergeId: 3, Tag: "AGE", Name: "Age", Type: MergeFieldTypeNumber},
+	}
+
+	tests := []struct {
+		name           string
+		desired        []MergeFieldDefinition
+		deleteUnlisted bool
+		wantErr        bool
+		wantCreate     []string
+		wantUpdate     []int
+		wantDelete     []int
+	}{
+		{
+			name: "tags are matched case insensitively",
+			desired: []MergeFieldDefinition{
+				{Tag: "fname", Name: "First name", Type: MergeFieldTypeText},
+				{Tag: "Lname", Name: "Surname", Type: MergeFieldTypeText},
+				{Tag: "city", Name: "City", Type: MergeFieldTypeText},
+			},
+			wantCreate: []string{"city"},
+			wantUpdate: []int{2},
+		},
+		{
+			name: "unlisted fields are kept by default",
+			desired: []MergeFieldDefinition{
+				{Tag: "FNAME", Name: "First name", Type: MergeFieldTypeText},
+			},
+		},
+		{
+			name: "unlisted fields are deleted if requested",
+			desired: []MergeFieldDefinition{
+				{Tag: "fname", Name: "First name", Type: MergeFieldTypeText},
+			},
+			deleteUnlisted: true,
+			wantDelete:     []int{2, 3},
+		},
+		{
+			name: "duplicate tags are rejected",
+			desired: []MergeFieldDefinition{
+				{Tag: "CITY", Name: "City", Type: MergeFieldTypeText},
+				{Tag: "city", Name: "Town", Type: MergeFieldTypeText},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing tag is rejected",
+			desired: []MergeFieldDefinition{
+				{Name: "City", Type: MergeFieldTypeText},
+			},
+			wantErr: true,
+		},
+		{
+			name: "type change is rejected",
+			desired: []MergeFieldDefinition{
+				{Tag: "AGE", Name: "Age", Type: MergeFieldTypeText},
+			},
+			deleteUnlisted: true,
+			wantErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff, e := DiffMergeFields(current, tt.desired, tt.deleteUnlisted)
+			if tt.wantErr {
+				if e == nil {
+					t.Fatalf("expected error, got diff %+v", diff)
+				}
+				return
+			}
+			if e != nil {
+				t.Fatalf("unexpected error: %s", e.Message())
+			}
+
+			var create []string
+			for _, definition := range diff.Create {
+				create = append(create, definition.Tag)
+			}
+			var update []int
+			for _, u := range diff.Update {
+				update = append(update, u.Current.MergeId)
+			}
+			var del []int
+			for _, mergeField := range diff.Delete {
+				del = append(del, mergeField.MergeId)
+			}
+
+			if !equalStrings(create, tt.wantCreate) {
+				t.Errorf("got create %v, want %v", create, tt.wantCreate)
+			}
+			if !equalInts(update, tt.wantUpdate) {
+				t.Errorf("got update %v, want %v", update, tt.wantUpdate)
+			}
+			if !equalInts(del, tt.wantDelete) {
+				t.Errorf("got delete %v, want %v", del, tt.wantDelete)
+			}
+		})
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,207 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	"github.com/leapforce-libraries/go_mailchimp/types"
+)
+
+type Note struct {
+	Id        int                   `json:"id"`
+	CreatedAt *types.DateTimeString `json:"created_at"`
+	CreatedBy string                `json:"created_by"`
+	UpdatedAt *types.DateTimeString `json:"updated_at"`
+	Note      string                `json:"note"`
+	ListId    string                `json:"list_id"`
+	EmailId   string                `json:"email_id"`
+	ContactId string                `json:"contact_id"`
+	Links     []Link                `json:"_links"`
+}
+
+type ListMemberNotesConfig struct {
+	ListId        string
+	EmailAddress  string
+	Fields        *[]string
+	ExcludeFields *[]string
+	Count         *int64
+	SortField     *string
+	SortDir       *string
+}
+
+type ListMemberNotesResponse struct {
+	Notes      []Note `json:"notes"`
+	ListId     string `json:"list_id"`
+	EmailId    string `json:"email_id"`
+	TotalItems int    `json:"total_items"`
+	Links      []Link `json:"_links"`
+}
+
+func (service *Service) ListMemberNotes(cfg *ListMemberNotesConfig) (*[]Note, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListMemberNotesConfig must not be nil")
+	}
+
+	var notes []Note
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var count = countDefault
+	if cfg.Count != nil {
+		count = *cfg.Count
+	}
+	values.Set("count", fmt.Sprintf("%v", count))
+
+	if cfg.SortField != nil {
+		values.Set("sort_field", *cfg.SortField)
+	}
+
+	if cfg.SortDir != nil {
+		values.Set("sort_dir", *cfg.SortDir)
+	}
+
+	for {
+		var response ListMemberNotesResponse
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("lists/%s/members/%s/notes?%s", cfg.ListId, SubscriberHash(cfg.EmailAddress), values.Encode())),
+			ResponseModel: &response,
+		}
+
+		_, _, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		notes = append(notes, response.Notes...)
+
+		if len(notes) >= response.TotalItems {
+			break
+		}
+
+		values.Set("offset", fmt.Sprintf("%v", len(notes)))
+	}
+
+	return &notes, nil
+}
+
+type GetMemberNoteConfig struct {
+	ListId       string
+	EmailAddress string
+	NoteId       int
+}
+
+func (service *Service) GetMemberNote(cfg *GetMemberNoteConfig) (*Note, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("GetMemberNoteConfig must not be nil")
+	}
+
+	var note Note
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("lists/%s/members/%s/notes/%v", cfg.ListId, SubscriberHash(cfg.EmailAddress), cfg.NoteId)),
+		ResponseModel: &note,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &note, nil
+}
+
+type CreateMemberNoteConfig struct {
+	ListId       string `json:"-"`
+	EmailAddress string `json:"-"`
+	Note         string `json:"note"`
+}
+
+func (service *Service) CreateMemberNote(cfg *CreateMemberNoteConfig) (*Note, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("CreateMemberNoteConfig must not be nil")
+	}
+
+	var note Note
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url(fmt.Sprintf("lists/%s/members/%s/notes", cfg.ListId, SubscriberHash(cfg.EmailAddress))),
+		BodyModel:     cfg,
+		ResponseModel: &note,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &note, nil
+}
+
+type UpdateMemberNoteConfig struct {
+	ListId       string `json:"-"`
+	EmailAddress string `json:"-"`
+	NoteId       int    `json:"-"`
+	Note         string `json:"note"`
+}
+
+func (service *Service) UpdateMemberNote(cfg *UpdateMemberNoteConfig) (*Note, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("UpdateMemberNoteConfig must not be nil")
+	}
+
+	var note Note
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPatch,
+		Url:           service.url(fmt.Sprintf("lists/%s/members/%s/notes/%v", cfg.ListId, SubscriberHash(cfg.EmailAddress), cfg.NoteId)),
+		BodyModel:     cfg,
+		ResponseModel: &note,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &note, nil
+}
+
+type DeleteMemberNoteConfig struct {
+	ListId       string
+	EmailAddress string
+	NoteId       int
+}
+
+func (service *Service) DeleteMemberNote(cfg *DeleteMemberNoteConfig) *errortools.Error {
+	if cfg == nil {
+		return errortools.ErrorMessage("DeleteMemberNoteConfig must not be nil")
+	}
+
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodDelete,
+		Url:    service.url(fmt.Sprintf("lists/%s/members/%s/notes/%v", cfg.ListId, SubscriberHash(cfg.EmailAddress), cfg.NoteId)),
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return e
+	}
+
+	return nil
+}
@@ -0,0 +1,440 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+	"github.com/leapforce-libraries/go_mailchimp/types"
+)
+
+const (
+	segmentBatchSizeMax int = 500
+)
+
+type Segment struct {
+	Id          int                   `json:"id"`
+	Name        string                `json:"name"`
+	MemberCount int                   `json:"member_count"`
+	Type        SegmentType           `json:"type"`
+	CreatedAt   *types.DateTimeString `json:"created_at"`
+	UpdatedAt   *types.DateTimeString `json:"updated_at"`
+	Options     SegmentOptions        `json:"options"`
+	ListId      string                `json:"list_id"`
+	Links       []Link                `json:"_links"`
+}
+
+type SegmentOptions struct {
+	Match      SegmentMatch       `json:"match"`
+	Conditions []SegmentCondition `json:"conditions"`
+}
+
+type SegmentType string
+
+const (
+	SegmentTypeSaved  SegmentType = "saved"
+	SegmentTypeStatic SegmentType = "static"
+	SegmentTypeFuzzy  SegmentType = "fuzzy"
+)
+
+type ListSegmentsConfig struct {
+	ListId               string
+	Fields               *[]string
+	ExcludeFields        *[]string
+	Count                *int64
+	Type                 *SegmentType
+	SinceCreatedAt       *time.Time
+	BeforeCreatedAt      *time.Time
+	IncludeCleaned       *bool
+	IncludeTransactional *bool
+	IncludeUnsubscribed  *bool
+	SinceUpdatedAt       *time.Time
+	BeforeUpdatedAt      *time.Time
+}
+
+type ListSegmentsResponse struct {
+	Segments   []Segment `json:"segments"`
+	ListId     string    `json:"list_id"`
+	TotalItems int       `json:"total_items"`
+	Links      []Link    `json:"_links"`
+}
+
+func (service *Service) ListSegments(cfg *ListSegmentsConfig) (*[]Segment, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListSegmentsConfig must not be nil")
+	}
+
+	var segments []Segment
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var count = countDefault
+	if cfg.Count != nil {
+		count = *cfg.Count
+	}
+	values.Set("count", fmt.Sprintf("%v", count))
+
+	if cfg.Type != nil {
+		values.Set("type", string(*cfg.Type))
+	}
+
+	if cfg.SinceCreatedAt != nil {
+		values.Set("since_created_at", (*cfg.SinceCreatedAt).Format(types.DateTimeFormat))
+	}
+
+	if cfg.BeforeCreatedAt != nil {
+		values.Set("before_created_at", (*cfg.BeforeCreatedAt).Format(types.DateTimeFormat))
+	}
+
+	if cfg.IncludeCleaned != nil {
+		values.Set("include_cleaned", fmt.Sprintf("%v", *cfg.IncludeCleaned))
+	}
+
+	if cfg.IncludeTransactional != nil {
+		values.Set("include_transactional", fmt.Sprintf("%v", *cfg.IncludeTransactional))
+	}
+
+	if cfg.IncludeUnsubscribed != nil {
+		values.Set("include_unsubscribed", fmt.Sprintf("%v", *cfg.IncludeUnsubscribed))
+	}
+
+	if cfg.SinceUpdatedAt != nil {
+		values.Set("since_updated_at", (*cfg.SinceUpdatedAt).Format(types.DateTimeFormat))
+	}
+
+	if cfg.BeforeUpdatedAt != nil {
+		values.Set("before_updated_at", (*cfg.BeforeUpdatedAt).Format(types.DateTimeFormat))
+	}
+
+	for {
+		var response ListSegmentsResponse
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("lists/%s/segments?%s", cfg.ListId, values.Encode())),
+			ResponseModel: &response,
+		}
+
+		_, _, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		segments = append(segments, response.Segments...)
+
+		if len(segments) >= response.TotalItems {
+			break
+		}
+
+		values.Set("offset", fmt.Sprintf("%v", len(segments)))
+	}
+
+	return &segments, nil
+}
+
+type GetSegmentConfig struct {
+	ListId               string
+	SegmentId            int
+	IncludeCleaned       *bool
+	IncludeTransactional *bool
+	IncludeUnsubscribed  *bool
+}
+
+func (service *Service) GetSegment(cfg *GetSegmentConfig) (*Segment, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("GetSegmentConfig must not be nil")
+	}
+
+	var values = url.Values{}
+
+	if cfg.IncludeCleaned != nil {
+		values.Set("include_cleaned", fmt.Sprintf("%v", *cfg.IncludeCleaned))
+	}
+
+	if cfg.IncludeTransactional != nil {
+		values.Set("include_transactional", fmt.Sprintf("%v", *cfg.IncludeTransactional))
+	}
+
+	if cfg.IncludeUnsubscribed != nil {
+		values.Set("include_unsubscribed", fmt.Sprintf("%v", *cfg.IncludeUnsubscribed))
+	}
+
+	var segment Segment
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("lists/%s/segments/%v?%s", cfg.ListId, cfg.SegmentId, values.Encode())),
+		ResponseModel: &segment,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &segment, nil
+}
+
+// CreateSegmentConfig creates a static segment if StaticSegment is set, a saved segment if Options is set
+type CreateSegmentConfig struct {
+	ListId        string          `json:"-"`
+	Name          string          `json:"name"`
+	StaticSegment *[]string       `json:"static_segment,omitempty"`
+	Options       *SegmentOptions `json:"options,omitempty"`
+}
+
+func (service *Service) CreateSegment(cfg *CreateSegmentConfig) (*Segment, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("CreateSegmentConfig must not be nil")
+	}
+
+	if cfg.StaticSegment != nil && cfg.Options != nil {
+		return nil, errortools.ErrorMessage("StaticSegment and Options cannot both be provided")
+	}
+
+	var segment Segment
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url(fmt.Sprintf("lists/%s/segments", cfg.ListId)),
+		BodyModel:     cfg,
+		ResponseModel: &segment,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &segment, nil
+}
+
+type UpdateSegmentConfig struct {
+	ListId        string          `json:"-"`
+	SegmentId     int             `json:"-"`
+	Name          string          `json:"name"`
+	StaticSegment *[]string       `json:"static_segment,omitempty"`
+	Options       *SegmentOptions `json:"options,omitempty"`
+}
+
+func (service *Service) UpdateSegment(cfg *UpdateSegmentConfig) (*Segment, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("UpdateSegmentConfig must not be nil")
+	}
+
+	if cfg.StaticSegment != nil && cfg.Options != nil {
+		return nil, errortools.ErrorMessage("StaticSegment and Options cannot both be provided")
+	}
+
+	var segment Segment
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPatch,
+		Url:           service.url(fmt.Sprintf("lists/%s/segments/%v", cfg.ListId, cfg.SegmentId)),
+		BodyModel:     cfg,
+		ResponseModel: &segment,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &segment, nil
+}
+
+type DeleteSegmentConfig struct {
+	ListId    string
+	SegmentId int
+}
+
+func (service *Service) DeleteSegment(cfg *DeleteSegmentConfig) *errortools.Error {
+	if cfg == nil {
+		return errortools.ErrorMessage("DeleteSegmentConfig must not be nil")
+	}
+
+	requestConfig := go_http.RequestConfig{
+		Method: http.MethodDelete,
+		Url:    service.url(fmt.Sprintf("lists/%s/segments/%v", cfg.ListId, cfg.SegmentId)),
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return e
+	}
+
+	return nil
+}
+
+type ListSegmentMembersConfig struct {
+	ListId               string
+	SegmentId            int
+	Fields               *[]string
+	ExcludeFields        *[]string
+	Count                *int64
+	IncludeCleaned       *bool
+	IncludeTransactional *bool
+	IncludeUnsubscribed  *bool
+}
+
+type ListSegmentMembersResponse struct {
+	Members    []ListMember `json:"members"`
+	TotalItems int          `json:"total_items"`
+	Links      []Link       `json:"_links"`
+}
+
+func (service *Service) ListSegmentMembers(cfg *ListSegmentMembersConfig) (*[]ListMember, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListSegmentMembersConfig must not be nil")
+	}
+
+	var members []ListMember
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var count = countDefault
+	if cfg.Count != nil {
+		count = *cfg.Count
+	}
+	values.Set("count", fmt.Sprintf("%v", count))
+
+	if cfg.IncludeCleaned != nil {
+		values.Set("include_cleaned", fmt.Sprintf("%v", *cfg.IncludeCleaned))
+	}
+
+	if cfg.IncludeTransactional != nil {
+		values.Set("include_transactional", fmt.Sprintf("%v", *cfg.IncludeTransactional))
+	}
+
+	if cfg.IncludeUnsubscribed != nil {
+		values.Set("include_unsubscribed", fmt.Sprintf("%v", *cfg.IncludeUnsubscribed))
+	}
+
+	for {
+		var response ListSegmentMembersResponse
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("lists/%s/segments/%v/members?%s", cfg.ListId, cfg.SegmentId, values.Encode())),
+			ResponseModel: &response,
+		}
+
+		_, _, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		members = append(members, response.Members...)
+
+		if len(members) >= response.TotalItems {
+			break
+		}
+
+		values.Set("offset", fmt.Sprintf("%v", len(members)))
+	}
+
+	return &members, nil
+}
+
+type BatchSegmentMembersConfig struct {
+	ListId          string
+	SegmentId       int
+	MembersToAdd    []string
+	MembersToRemove []string
+}
+
+type BatchSegmentMembersResponse struct {
+	MembersAdded   []ListMember               `json:"members_added"`
+	MembersRemoved []ListMember               `json:"members_removed"`
+	Errors         []BatchSegmentMembersError `json:"errors"`
+	TotalAdded     int                        `json:"total_added"`
+	TotalRemoved   int                        `json:"total_removed"`
+	ErrorCount     int                        `json:"error_count"`
+}
+
+type BatchSegmentMembersError struct {
+	EmailAddresses []string `json:"email_addresses"`
+	Error          string   `json:"error"`
+}
+
+type batchSegmentMembersBody struct {
+	MembersToAdd    []string `json:"members_to_add"`
+	MembersToRemove []string `json:"members_to_remove"`
+}
+
+// BatchSegmentMembers adds and removes members of a static segment.
+// Mailchimp accepts at most 500 email addresses per request, larger batches are split over multiple requests.
+func (service *Service) BatchSegmentMembers(cfg *BatchSegmentMembersConfig) (*BatchSegmentMembersResponse, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("BatchSegmentMembersConfig must not be nil")
+	}
+
+	var result BatchSegmentMembersResponse
+
+	toAdd := cfg.MembersToAdd
+	toRemove := cfg.MembersToRemove
+
+	for len(toAdd) > 0 || len(toRemove) > 0 {
+		var body = batchSegmentMembersBody{
+			MembersToAdd:    []string{},
+			MembersToRemove: []string{},
+		}
+
+		addCount := len(toAdd)
+		if addCount > segmentBatchSizeMax {
+			addCount = segmentBatchSizeMax
+		}
+		body.MembersToAdd = append(body.MembersToAdd, toAdd[:addCount]...)
+		toAdd = toAdd[addCount:]
+
+		removeCount := len(toRemove)
+		if removeCount > segmentBatchSizeMax-addCount {
+			removeCount = segmentBatchSizeMax - addCount
+		}
+		body.MembersToRemove = append(body.MembersToRemove, toRemove[:removeCount]...)
+		toRemove = toRemove[removeCount:]
+
+		var response BatchSegmentMembersResponse
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodPost,
+			Url:           service.url(fmt.Sprintf("lists/%s/segments/%v", cfg.ListId, cfg.SegmentId)),
+			BodyModel:     body,
+			ResponseModel: &response,
+		}
+
+		_, _, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		result.MembersAdded = append(result.MembersAdded, response.MembersAdded...)
+		result.MembersRemoved = append(result.MembersRemoved, response.MembersRemoved...)
+		result.Errors = append(result.Errors, response.Errors...)
+		result.TotalAdded += response.TotalAdded
+		result.TotalRemoved += response.TotalRemoved
+		result.ErrorCount += response.ErrorCount
+	}
+
+	return &result, nil
+}
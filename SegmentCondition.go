@@ -0,0 +1,353 @@
+package mailchimp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+type SegmentMatch string
+
+const (
+	SegmentMatchAny SegmentMatch = "any"
+	SegmentMatchAll SegmentMatch = "all"
+)
+
+type SegmentConditionType string
+
+const (
+	SegmentConditionTypeAim                 SegmentConditionType = "Aim"
+	SegmentConditionTypeAutomation          SegmentConditionType = "Automation"
+	SegmentConditionTypeCampaignPoll        SegmentConditionType = "CampaignPoll"
+	SegmentConditionTypeConversation        SegmentConditionType = "Conversation"
+	SegmentConditionTypeDate                SegmentConditionType = "Date"
+	SegmentConditionTypeEmailClient         SegmentConditionType = "EmailClient"
+	SegmentConditionTypeLanguage            SegmentConditionType = "Language"
+	SegmentConditionTypeMandrill            SegmentConditionType = "Mandrill"
+	SegmentConditionTypeMemberRating        SegmentConditionType = "MemberRating"
+	SegmentConditionTypeSignupSource        SegmentConditionType = "SignupSource"
+	SegmentConditionTypeSurveyMonkey        SegmentConditionType = "SurveyMonkey"
+	SegmentConditionTypeVip                 SegmentConditionType = "VIP"
+	SegmentConditionTypeInterests           SegmentConditionType = "Interests"
+	SegmentConditionTypeEcommCategory       SegmentConditionType = "EcommCategory"
+	SegmentConditionTypeEcommNumber         SegmentConditionType = "EcommNumber"
+	SegmentConditionTypeEcommPurchased      SegmentConditionType = "EcommPurchased"
+	SegmentConditionTypeEcommSpent          SegmentConditionType = "EcommSpent"
+	SegmentConditionTypeEcommStore          SegmentConditionType = "EcommStore"
+	SegmentConditionTypeGoalActivity        SegmentConditionType = "GoalActivity"
+	SegmentConditionTypeGoalTimestamp       SegmentConditionType = "GoalTimestamp"
+	SegmentConditionTypeFuzzySegment        SegmentConditionType = "FuzzySegment"
+	SegmentConditionTypeStaticSegment       SegmentConditionType = "StaticSegment"
+	SegmentConditionTypeIpGeoCountryState   SegmentConditionType = "IPGeoCountryState"
+	SegmentConditionTypeIpGeoIn             SegmentConditionType = "IPGeoIn"
+	SegmentConditionTypeIpGeoInZip          SegmentConditionType = "IPGeoInZip"
+	SegmentConditionTypeIpGeoUnknown        SegmentConditionType = "IPGeoUnknown"
+	SegmentConditionTypeIpGeoZip            SegmentConditionType = "IPGeoZip"
+	SegmentConditionTypeSocialAge           SegmentConditionType = "SocialAge"
+	SegmentConditionTypeSocialGender        SegmentConditionType = "SocialGender"
+	SegmentConditionTypeSocialInfluence     SegmentConditionType = "SocialInfluence"
+	SegmentConditionTypeSocialNetworkMember SegmentConditionType = "SocialNetworkMember"
+	SegmentConditionTypeSocialNetworkFollow SegmentConditionType = "SocialNetworkFollow"
+	SegmentConditionTypeAddressMerge        SegmentConditionType = "AddressMerge"
+	SegmentConditionTypeZipMerge            SegmentConditionType = "ZipMerge"
+	SegmentConditionTypeBirthdayMerge       SegmentConditionType = "BirthdayMerge"
+	SegmentConditionTypeDateMerge           SegmentConditionType = "DateMerge"
+	SegmentConditionTypeEmailAddress        SegmentConditionType = "EmailAddress"
+	SegmentConditionTypeTextMerge           SegmentConditionType = "TextMerge"
+	SegmentConditionTypeSelectMerge         SegmentConditionType = "SelectMerge"
+	SegmentConditionTypePredictedGender     SegmentConditionType = "PredictedGender"
+	SegmentConditionTypePredictedAge        SegmentConditionType = "PredictedAge"
+	SegmentConditionTypeNewSubscribers      SegmentConditionType = "NewSubscribers"
+)
+
+type SegmentConditionOp string
+
+const (
+	SegmentConditionOpIs                  SegmentConditionOp = "is"
+	SegmentConditionOpNot                 SegmentConditionOp = "not"
+	SegmentConditionOpContains            SegmentConditionOp = "contains"
+	SegmentConditionOpNotContain          SegmentConditionOp = "notcontain"
+	SegmentConditionOpStarts              SegmentConditionOp = "starts"
+	SegmentConditionOpEnds                SegmentConditionOp = "ends"
+	SegmentConditionOpGreater             SegmentConditionOp = "greater"
+	SegmentConditionOpLess                SegmentConditionOp = "less"
+	SegmentConditionOpBlank               SegmentConditionOp = "blank"
+	SegmentConditionOpBlankNot            SegmentConditionOp = "blank_not"
+	SegmentConditionOpOpen                SegmentConditionOp = "open"
+	SegmentConditionOpClick               SegmentConditionOp = "click"
+	SegmentConditionOpSent                SegmentConditionOp = "sent"
+	SegmentConditionOpNoOpen              SegmentConditionOp = "noopen"
+	SegmentConditionOpNoClick             SegmentConditionOp = "noclick"
+	SegmentConditionOpNoSent              SegmentConditionOp = "nosent"
+	SegmentConditionOpStarted             SegmentConditionOp = "started"
+	SegmentConditionOpCompleted           SegmentConditionOp = "completed"
+	SegmentConditionOpNotStarted          SegmentConditionOp = "not_started"
+	SegmentConditionOpNotCompleted        SegmentConditionOp = "not_completed"
+	SegmentConditionOpMember              SegmentConditionOp = "member"
+	SegmentConditionOpNotMember           SegmentConditionOp = "notmember"
+	SegmentConditionOpInterestContains    SegmentConditionOp = "interestcontains"
+	SegmentConditionOpInterestContainsAll SegmentConditionOp = "interestcontainsall"
+	SegmentConditionOpInterestNotContains SegmentConditionOp = "interestnotcontains"
+	SegmentConditionOpStaticIs            SegmentConditionOp = "static_is"
+	SegmentConditionOpStaticNot           SegmentConditionOp = "static_not"
+	SegmentConditionOpPurchased           SegmentConditionOp = "purchased"
+	SegmentConditionOpNotPurchased        SegmentConditionOp = "notpurchased"
+)
+
+// SegmentCondition is a single segment rule.
+// Which of the optional fields are used depends on ConditionType, e.g. IPGeoIn uses Lat, Lng and Addr.
+type SegmentCondition struct {
+	ConditionType SegmentConditionType   `json:"condition_type"`
+	Field         string                 `json:"field"`
+	Op            SegmentConditionOp     `json:"op"`
+	Value         *SegmentConditionValue `json:"value,omitempty"`
+	Extra         *SegmentConditionValue `json:"extra,omitempty"`
+	Lat           string                 `json:"lat,omitempty"`
+	Lng           string                 `json:"lng,omitempty"`
+	Addr          string                 `json:"addr,omitempty"`
+}
+
+// SegmentConditionValue holds a condition value, which can be a string, a number or an array of strings or numbers.
+// Exactly one of the fields is set, any other value shape is kept as is in Raw.
+type SegmentConditionValue struct {
+	String  *string
+	Number  *float64
+	Strings *[]string
+	Numbers *[]float64
+	Raw     json.RawMessage
+}
+
+func NewSegmentConditionString(s string) *SegmentConditionValue {
+	return &SegmentConditionValue{String: &s}
+}
+
+func NewSegmentConditionNumber(f float64) *SegmentConditionValue {
+	return &SegmentConditionValue{Number: &f}
+}
+
+func NewSegmentConditionStrings(s []string) *SegmentConditionValue {
+	return &SegmentConditionValue{Strings: &s}
+}
+
+func NewSegmentConditionNumbers(f []float64) *SegmentConditionValue {
+	return &SegmentConditionValue{Numbers: &f}
+}
+
+func (v SegmentConditionValue) MarshalJSON() ([]byte, error) {
+	if v.String != nil {
+		return json.Marshal(*v.String)
+	}
+	if v.Number != nil {
+		return json.Marshal(*v.Number)
+	}
+	if v.Strings != nil {
+		return json.Marshal(*v.Strings)
+	}
+	if v.Numbers != nil {
+		return json.Marshal(*v.Numbers)
+	}
+	if len(v.Raw) > 0 {
+		return v.Raw, nil
+	}
+
+	return []byte("null"), nil
+}
+
+func (v *SegmentConditionValue) UnmarshalJSON(b []byte) error {
+	*v = SegmentConditionValue{}
+
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 || string(b) == "null" {
+		return nil
+	}
+
+	switch b[0] {
+	case '"':
+		var s string
+		if err := json.Unmarshal(b, &s); err == nil {
+			v.String = &s
+			return nil
+		}
+	case '[':
+		var s []string
+		if err := json.Unmarshal(b, &s); err == nil {
+			v.Strings = &s
+			return nil
+		}
+		var f []float64
+		if err := json.Unmarshal(b, &f); err == nil {
+			v.Numbers = &f
+			return nil
+		}
+	default:
+		var f float64
+		if err := json.Unmarshal(b, &f); err == nil {
+			v.Number = &f
+			return nil
+		}
+	}
+
+	// unexpected value shape, keep it unchanged
+	v.Raw = append(json.RawMessage{}, b...)
+
+	return nil
+}
+
+// SegmentOptionsBuilder composes SegmentOptions in code, for example:
+//
+//	options := NewSegmentOptionsBuilder(SegmentMatchAll).
+//		TextMerge("FNAME", SegmentConditionOpIs, "John").
+//		StaticSegment(SegmentConditionOpStaticIs, 1234).
+//		Build()
+type SegmentOptionsBuilder struct {
+	options SegmentOptions
+}
+
+func NewSegmentOptionsBuilder(match SegmentMatch) *SegmentOptionsBuilder {
+	return &SegmentOptionsBuilder{
+		options: SegmentOptions{
+			Match:      match,
+			Conditions: []SegmentCondition{},
+		},
+	}
+}
+
+func (builder *SegmentOptionsBuilder) Build() *SegmentOptions {
+	options := SegmentOptions{
+		Match:      builder.options.Match,
+		Conditions: append([]SegmentCondition{}, builder.options.Conditions...),
+	}
+
+	return &options
+}
+
+// Condition adds a condition as is, for condition types without a dedicated builder method
+func (builder *SegmentOptionsBuilder) Condition(condition SegmentCondition) *SegmentOptionsBuilder {
+	builder.options.Conditions = append(builder.options.Conditions, condition)
+	return builder
+}
+
+func (builder *SegmentOptionsBuilder) add(conditionType SegmentConditionType, field string, op SegmentConditionOp, value *SegmentConditionValue) *SegmentOptionsBuilder {
+	return builder.Condition(SegmentCondition{
+		ConditionType: conditionType,
+		Field:         field,
+		Op:            op,
+		Value:         value,
+	})
+}
+
+// Aim filters on campaign activity, campaignId can also be "any" or "last"
+func (builder *SegmentOptionsBuilder) Aim(op SegmentConditionOp, campaignId string) *SegmentOptionsBuilder {
+	return builder.add(SegmentConditionTypeAim, "aim", op, NewSegmentConditionString(campaignId))
+}
+
+func (builder *SegmentOptionsBuilder) Automation(op SegmentConditionOp, workflowId string) *SegmentOptionsBuilder {
+	return builder.add(SegmentConditionTypeAutomation, "automation", op, NewSegmentConditionString(workflowId))
+}
+
+func (builder *SegmentOptionsBuilder) EmailAddress(op SegmentConditionOp, value string) *SegmentOptionsBuilder {
+	return builder.add(SegmentConditionTypeEmailAddress, "EMAIL", op, NewSegmentConditionString(value))
+}
+
+// Date filters on a date field such as "timestamp_opt" or "info_changed".
+// value is a relative value such as "last" or "date", extra holds the specific date (yyyy-mm-dd) if any.
+func (builder *SegmentOptionsBuilder) Date(field string, op SegmentConditionOp, value string, extra *string) *SegmentOptionsBuilder {
+	condition := SegmentCondition{
+		ConditionType: SegmentConditionTypeDate,
+		Field:         field,
+		Op:            op,
+		Value:         NewSegmentConditionString(value),
+	}
+	if extra != nil {
+		condition.Extra = NewSegmentConditionString(*extra)
+	}
+
+	return builder.Condition(condition)
+}
+
+func (builder *SegmentOptionsBuilder) DateMerge(field string, op SegmentConditionOp, value string) *SegmentOptionsBuilder {
+	return builder.add(SegmentConditionTypeDateMerge, field, op, NewSegmentConditionString(value))
+}
+
+func (builder *SegmentOptionsBuilder) BirthdayMerge(field string, op SegmentConditionOp, value string) *SegmentOptionsBuilder {
+	return builder.add(SegmentConditionTypeBirthdayMerge, field, op, NewSegmentConditionString(value))
+}
+
+func (builder *SegmentOptionsBuilder) TextMerge(field string, op SegmentConditionOp, value string) *SegmentOptionsBuilder {
+	return builder.add(SegmentConditionTypeTextMerge, field, op, NewSegmentConditionString(value))
+}
+
+func (builder *SegmentOptionsBuilder) SelectMerge(field string, op SegmentConditionOp, value string) *SegmentOptionsBuilder {
+	return builder.add(SegmentConditionTypeSelectMerge, field, op, NewSegmentConditionString(value))
+}
+
+// Interests filters on the interests of a category, the field is generated as "interests-{interestCategoryId}"
+func (builder *SegmentOptionsBuilder) Interests(interestCategoryId string, op SegmentConditionOp, interestIds []string) *SegmentOptionsBuilder {
+	return builder.add(SegmentConditionTypeInterests, fmt.Sprintf("interests-%s", interestCategoryId), op, NewSegmentConditionStrings(interestIds))
+}
+
+func (builder *SegmentOptionsBuilder) EcommCategory(field string, op SegmentConditionOp, value string) *SegmentOptionsBuilder {
+	return builder.add(SegmentConditionTypeEcommCategory, field, op, NewSegmentConditionString(value))
+}
+
+func (builder *SegmentOptionsBuilder) EcommNumber(field string, op SegmentConditionOp, value float64) *SegmentOptionsBuilder {
+	return builder.add(SegmentConditionTypeEcommNumber, field, op, NewSegmentConditionNumber(value))
+}
+
+func (builder *SegmentOptionsBuilder) EcommPurchased(op SegmentConditionOp) *SegmentOptionsBuilder {
+	return builder.add(SegmentConditionTypeEcommPurchased, "ecomm_purchased", op, nil)
+}
+
+func (builder *SegmentOptionsBuilder) EcommSpent(field string, op SegmentConditionOp, value float64) *SegmentOptionsBuilder {
+	return builder.add(SegmentConditionTypeEcommSpent, field, op, NewSegmentConditionNumber(value))
+}
+
+func (builder *SegmentOptionsBuilder) EcommStore(op SegmentConditionOp, storeId string) *SegmentOptionsBuilder {
+	return builder.add(SegmentConditionTypeEcommStore, "ecomm_store", op, NewSegmentConditionString(storeId))
+}
+
+// StaticSegment filters on membership of a static segment or tag
+func (builder *SegmentOptionsBuilder) StaticSegment(op SegmentConditionOp, segmentId int) *SegmentOptionsBuilder {
+	return builder.add(SegmentConditionTypeStaticSegment, "static_segment", op, NewSegmentConditionNumber(float64(segmentId)))
+}
+
+func (builder *SegmentOptionsBuilder) FuzzySegment(op SegmentConditionOp, segmentId int) *SegmentOptionsBuilder {
+	return builder.add(SegmentConditionTypeFuzzySegment, "fuzzy_segment", op, NewSegmentConditionNumber(float64(segmentId)))
+}
+
+func (builder *SegmentOptionsBuilder) Language(op SegmentConditionOp, language string) *SegmentOptionsBuilder {
+	return builder.add(SegmentConditionTypeLanguage, "language", op, NewSegmentConditionString(language))
+}
+
+func (builder *SegmentOptionsBuilder) MemberRating(op SegmentConditionOp, rating int) *SegmentOptionsBuilder {
+	return builder.add(SegmentConditionTypeMemberRating, "rating", op, NewSegmentConditionNumber(float64(rating)))
+}
+
+func (builder *SegmentOptionsBuilder) Vip(op SegmentConditionOp) *SegmentOptionsBuilder {
+	return builder.add(SegmentConditionTypeVip, "gmonkey", op, nil)
+}
+
+func (builder *SegmentOptionsBuilder) SignupSource(op SegmentConditionOp, source string) *SegmentOptionsBuilder {
+	return builder.add(SegmentConditionTypeSignupSource, "source", op, NewSegmentConditionString(source))
+}
+
+func (builder *SegmentOptionsBuilder) EmailClient(op SegmentConditionOp, client string) *SegmentOptionsBuilder {
+	return builder.add(SegmentConditionTypeEmailClient, "email_client", op, NewSegmentConditionString(client))
+}
+
+func (builder *SegmentOptionsBuilder) IpGeoCountryState(op SegmentConditionOp, countryState string) *SegmentOptionsBuilder {
+	return builder.add(SegmentConditionTypeIpGeoCountryState, "ipgeo", op, NewSegmentConditionString(countryState))
+}
+
+// IpGeoIn filters on members within distance (in miles) of a location
+func (builder *SegmentOptionsBuilder) IpGeoIn(op SegmentConditionOp, distance int, lat string, lng string, addr string) *SegmentOptionsBuilder {
+	return builder.Condition(SegmentCondition{
+		ConditionType: SegmentConditionTypeIpGeoIn,
+		Field:         "ipgeo",
+		Op:            op,
+		Value:         NewSegmentConditionNumber(float64(distance)),
+		Lat:           lat,
+		Lng:           lng,
+		Addr:          addr,
+	})
+}
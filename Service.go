@@ -64,13 +64,14 @@ func (service *Service) httpRequest(requestConfig *go_http.RequestConfig) (*http
 	requestConfig.NonDefaultHeaders = headers
 
 	// add error model
-	service.errorResponse = &ErrorResponse{}
-	requestConfig.ErrorModel = service.errorResponse
+	errorResponse := ErrorResponse{}
+	requestConfig.ErrorModel = &errorResponse
 
 	request, response, e := service.httpService.HttpRequest(requestConfig)
+	service.errorResponse = &errorResponse
 	if e != nil {
-		if service.errorResponse.Message != "" {
-			e.SetMessage(service.errorResponse.Message)
+		if errorResponse.Message != "" {
+			e.SetMessage(errorResponse.Message)
 		}
 	}
 
@@ -0,0 +1,108 @@
+package mailchimp
+
+import (
+	"fmt"
+	"net/http"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+)
+
+type SignupForm struct {
+	Header        SignupFormHeader    `json:"header"`
+	Contents      []SignupFormContent `json:"contents"`
+	Styles        []SignupFormStyle   `json:"styles"`
+	SignupFormUrl string              `json:"signup_form_url"`
+	ListId        string              `json:"list_id"`
+	Links         []Link              `json:"_links"`
+}
+
+type SignupFormHeader struct {
+	ImageUrl         string `json:"image_url,omitempty"`
+	Text             string `json:"text,omitempty"`
+	ImageWidth       string `json:"image_width,omitempty"`
+	ImageHeight      string `json:"image_height,omitempty"`
+	ImageAlt         string `json:"image_alt,omitempty"`
+	ImageLink        string `json:"image_link,omitempty"`
+	ImageAlign       string `json:"image_align,omitempty"`
+	ImageBorderWidth string `json:"image_border_width,omitempty"`
+	ImageBorderStyle string `json:"image_border_style,omitempty"`
+	ImageBorderColor string `json:"image_border_color,omitempty"`
+	ImageTarget      string `json:"image_target,omitempty"`
+}
+
+type SignupFormContent struct {
+	Section string `json:"section"`
+	Value   string `json:"value"`
+}
+
+type SignupFormStyle struct {
+	Selector string                  `json:"selector"`
+	Options  []SignupFormStyleOption `json:"options"`
+}
+
+type SignupFormStyleOption struct {
+	Property string `json:"property"`
+	Value    string `json:"value"`
+}
+
+type ListSignupFormsConfig struct {
+	ListId string
+}
+
+type ListSignupFormsResponse struct {
+	SignupForms []SignupForm `json:"signup_forms"`
+	ListId      string       `json:"list_id"`
+	TotalItems  int          `json:"total_items"`
+	Links       []Link       `json:"_links"`
+}
+
+func (service *Service) ListSignupForms(cfg *ListSignupFormsConfig) (*[]SignupForm, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListSignupFormsConfig must not be nil")
+	}
+
+	var response ListSignupFormsResponse
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.url(fmt.Sprintf("lists/%s/signup-forms", cfg.ListId)),
+		ResponseModel: &response,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &response.SignupForms, nil
+}
+
+type CustomizeSignupFormConfig struct {
+	ListId   string               `json:"-"`
+	Header   *SignupFormHeader    `json:"header,omitempty"`
+	Contents *[]SignupFormContent `json:"contents,omitempty"`
+	Styles   *[]SignupFormStyle   `json:"styles,omitempty"`
+}
+
+func (service *Service) CustomizeSignupForm(cfg *CustomizeSignupFormConfig) (*SignupForm, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("CustomizeSignupFormConfig must not be nil")
+	}
+
+	var signupForm SignupForm
+
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodPost,
+		Url:           service.url(fmt.Sprintf("lists/%s/signup-forms", cfg.ListId)),
+		BodyModel:     cfg,
+		ResponseModel: &signupForm,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return nil, e
+	}
+
+	return &signupForm, nil
+}
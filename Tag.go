@@ -4,8 +4,10 @@ import (
 	"fmt"
 	errortools "github.com/leapforce-libraries/go_errortools"
 	go_http "github.com/leapforce-libraries/go_http"
+	"github.com/leapforce-libraries/go_mailchimp/types"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 type Tag struct {
@@ -61,3 +63,178 @@ func (service *Service) SearchTags(cfg *SearchTagsConfig) (*[]Tag, *errortools.E
 
 	return &tags, nil
 }
+
+type MemberTag struct {
+	Id        int64                 `json:"id"`
+	Name      string                `json:"name"`
+	DateAdded *types.DateTimeString `json:"date_added"`
+}
+
+type TagStatus string
+
+const (
+	TagStatusActive   TagStatus = "active"
+	TagStatusInactive TagStatus = "inactive"
+)
+
+type ListMemberTagsConfig struct {
+	ListId        string
+	EmailAddress  string
+	Fields        *[]string
+	ExcludeFields *[]string
+	Count         *int64
+}
+
+type ListMemberTagsResponse struct {
+	Tags       []MemberTag `json:"tags"`
+	TotalItems int         `json:"total_items"`
+	Links      []Link      `json:"_links"`
+}
+
+func (service *Service) ListMemberTags(cfg *ListMemberTagsConfig) (*[]MemberTag, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("ListMemberTagsConfig must not be nil")
+	}
+
+	var tags []MemberTag
+
+	var values = url.Values{}
+
+	if cfg.Fields != nil {
+		values.Set("fields", strings.Join(*cfg.Fields, ","))
+	}
+
+	if cfg.ExcludeFields != nil {
+		values.Set("exclude_fields", strings.Join(*cfg.ExcludeFields, ","))
+	}
+
+	var count = countDefault
+	if cfg.Count != nil {
+		count = *cfg.Count
+	}
+	values.Set("count", fmt.Sprintf("%v", count))
+
+	for {
+		var response ListMemberTagsResponse
+
+		requestConfig := go_http.RequestConfig{
+			Method:        http.MethodGet,
+			Url:           service.url(fmt.Sprintf("lists/%s/members/%s/tags?%s", cfg.ListId, SubscriberHash(cfg.EmailAddress), values.Encode())),
+			ResponseModel: &response,
+		}
+
+		_, _, e := service.httpRequest(&requestConfig)
+		if e != nil {
+			return nil, e
+		}
+
+		tags = append(tags, response.Tags...)
+
+		if len(tags) >= response.TotalItems {
+			break
+		}
+
+		values.Set("offset", fmt.Sprintf("%v", len(tags)))
+	}
+
+	return &tags, nil
+}
+
+type TagUpdate struct {
+	Name   string    `json:"name"`
+	Status TagStatus `json:"status"`
+}
+
+type UpdateMemberTagsConfig struct {
+	ListId       string      `json:"-"`
+	EmailAddress string      `json:"-"`
+	Tags         []TagUpdate `json:"tags"`
+	IsSyncing    *bool       `json:"is_syncing,omitempty"`
+}
+
+// UpdateMemberTags adds (status active) or removes (status inactive) tags on a list member, tags that do not exist yet are created
+func (service *Service) UpdateMemberTags(cfg *UpdateMemberTagsConfig) *errortools.Error {
+	if cfg == nil {
+		return errortools.ErrorMessage("UpdateMemberTagsConfig must not be nil")
+	}
+
+	requestConfig := go_http.RequestConfig{
+		Method:    http.MethodPost,
+		Url:       service.url(fmt.Sprintf("lists/%s/members/%s/tags", cfg.ListId, SubscriberHash(cfg.EmailAddress))),
+		BodyModel: cfg,
+	}
+
+	_, _, e := service.httpRequest(&requestConfig)
+	if e != nil {
+		return e
+	}
+
+	return nil
+}
+
+type BulkUpdateTagConfig struct {
+	ListId         string
+	Name           string
+	Status         TagStatus
+	EmailAddresses []string
+}
+
+// BulkUpdateTag applies (status active) or removes (status inactive) a single tag for many members at once.
+// Since tags are static segments, the members are added to or removed from the tag's segment in batches, creating the tag if needed.
+func (service *Service) BulkUpdateTag(cfg *BulkUpdateTagConfig) (*BatchSegmentMembersResponse, *errortools.Error) {
+	if cfg == nil {
+		return nil, errortools.ErrorMessage("BulkUpdateTagConfig must not be nil")
+	}
+
+	if cfg.Status != TagStatusActive && cfg.Status != TagStatusInactive {
+		return nil, errortools.ErrorMessage(fmt.Sprintf("Invalid tag status '%s'", cfg.Status))
+	}
+
+	tags, e := service.SearchTags(&SearchTagsConfig{
+		ListId: cfg.ListId,
+		Name:   &cfg.Name,
+	})
+	if e != nil {
+		return nil, e
+	}
+
+	var segmentId *int
+
+	for _, tag := range *tags {
+		if tag.Name == cfg.Name {
+			id := int(tag.Id)
+			segmentId = &id
+			break
+		}
+	}
+
+	if segmentId == nil {
+		if cfg.Status == TagStatusInactive {
+			return &BatchSegmentMembersResponse{}, nil
+		}
+
+		segment, e := service.CreateSegment(&CreateSegmentConfig{
+			ListId:        cfg.ListId,
+			Name:          cfg.Name,
+			StaticSegment: &[]string{},
+		})
+		if e != nil {
+			return nil, e
+		}
+
+		segmentId = &segment.Id
+	}
+
+	batchConfig := BatchSegmentMembersConfig{
+		ListId:    cfg.ListId,
+		SegmentId: *segmentId,
+	}
+
+	if cfg.Status == TagStatusActive {
+		batchConfig.MembersToAdd = cfg.EmailAddresses
+	} else {
+		batchConfig.MembersToRemove = cfg.EmailAddresses
+	}
+
+	return service.BatchSegmentMembers(&batchConfig)
+}
@@ -18,8 +18,9 @@ func (d *DateTimeString) UnmarshalJSON(b []byte) error {
 		return err
 	}
 
+	// an empty string leaves a zero time, which ValuePtr returns as nil
 	if strings.Trim(unquoted, " ") == "" {
-		d = nil
+		*d = DateTimeString(time.Time{})
 		return nil
 	}
 
@@ -32,8 +33,12 @@ func (d *DateTimeString) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+func (d DateTimeString) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(time.Time(d).Format(DateTimeFormat))), nil
+}
+
 func (d *DateTimeString) ValuePtr() *time.Time {
-	if d == nil {
+	if d == nil || time.Time(*d).IsZero() {
 		return nil
 	}
 